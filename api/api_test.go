@@ -15,10 +15,29 @@ import (
 )
 
 const (
-	executeEndpoint = "/api/v1/functions/execute"
-	installEndpoint = "/api/v1/functions/install"
-	resultEndpoint  = "/api/v1/functions/requests/result"
-	healthEndpoint  = "/api/v1/health"
+	executeEndpoint         = "/api/v1/functions/execute"
+	streamEndpoint          = "/api/v1/functions/execute/stream"
+	batchEndpoint           = "/api/v1/functions/execute/batch"
+	mapReduceEndpoint       = "/api/v1/functions/execute/mapreduce"
+	installEndpoint         = "/api/v1/functions/install"
+	installStatusEndpoint   = "/api/v1/functions/install/status"
+	uninstallEndpoint       = "/api/v1/functions/uninstall"
+	uninstallStatusEndpoint = "/api/v1/functions/uninstall/status"
+	resultEndpoint          = "/api/v1/functions/requests/result"
+	statusEndpoint          = "/api/v1/functions/requests/status"
+	cancelEndpoint          = "/api/v1/functions/requests/cancel"
+	schedulesEndpoint       = "/api/v1/schedules"
+	scheduleGetEndpoint     = "/api/v1/schedules/get"
+	scheduleRemoveEndpoint  = "/api/v1/schedules/remove"
+	workflowsEndpoint       = "/api/v1/workflows"
+	workflowStatusEndpoint  = "/api/v1/workflows/status"
+	reputationEndpoint      = "/api/v1/reputation"
+	reputationGetEndpoint   = "/api/v1/reputation/get"
+	nodeInfoEndpoint        = "/api/v1/node"
+	peersEndpoint           = "/api/v1/peers"
+	connectedPeersEndpoint  = "/api/v1/peers/connected"
+	functionsEndpoint       = "/api/v1/functions"
+	healthEndpoint          = "/api/v1/health"
 )
 
 func setupAPI(t *testing.T) *api.API {
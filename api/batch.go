@@ -0,0 +1,69 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// ExecuteFunctionBatch implements the REST API endpoint for executing a function for many sets of inputs.
+func (a *API) ExecuteFunctionBatch(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req BatchExecutionRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	batch := req.batchRequest()
+	err = batch.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	code, id, results, err := a.Node.ExecuteBatch(ctx.Request().Context(), batch, req.Topic)
+	if errors.Is(err, blockless.ErrExecutionQueueFull) {
+		return echo.NewHTTPError(http.StatusTooManyRequests, err)
+	}
+	if errors.Is(err, blockless.ErrExecutionQueueTimeout) {
+		return queueTimeoutError(ctx, err)
+	}
+	if err != nil {
+		a.Log.Warn().Str("function", req.FunctionId).Err(err).Msg("node failed to execute batch")
+	}
+
+	res := BatchExecutionResponse{
+		Code:      string(code),
+		RequestId: id,
+		Results:   results,
+	}
+
+	// Communicate the reason for failure in these cases.
+	if errors.Is(err, blockless.ErrRollCallTimeout) ||
+		errors.Is(err, blockless.ErrExecutionNotEnoughNodes) ||
+		errors.Is(err, blockless.ErrNotEnoughMatchingResults) {
+		res.Message = err.Error()
+	}
+
+	// Send the response.
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// batchRequest converts the API request to the format used by the node.
+func (r BatchExecutionRequest) batchRequest() execute.BatchRequest {
+
+	req := execute.BatchRequest{
+		FunctionID: r.FunctionId,
+		Method:     r.Method,
+		Config:     r.Config,
+		Items:      r.Items,
+	}
+
+	return req
+}
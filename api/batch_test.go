@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecuteBatch(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	req := api.BatchExecutionRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Items: []api.BatchExecutionItem{
+			{Parameters: []execute.Parameter{{Value: "first"}}},
+			{Parameters: []execute.Parameter{{Value: "second"}}},
+		},
+	}
+
+	rec, ctx, err := setupRecorder(batchEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunctionBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var res api.BatchExecutionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+	require.Len(t, res.Results, len(req.Items))
+	for i, item := range res.Results {
+		require.Equal(t, i, item.Index)
+		require.Equal(t, mocks.GenericExecutionResult.Result, item.Result.Result.Result)
+	}
+}
+
+func TestAPI_ExecuteBatch_HandlesErrors(t *testing.T) {
+	t.Run("no batch items", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.BatchExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		}
+
+		_, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionBatch(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("execution queue full", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteBatchFunc = func(context.Context, execute.BatchRequest, string) (codes.Code, string, []execute.BatchItemResult, error) {
+			return codes.TooManyRequests, "", nil, blockless.ErrExecutionQueueFull
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.BatchExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Items:      []api.BatchExecutionItem{{}},
+		}
+
+		_, ctx, err := setupRecorder(batchEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionBatch(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+	})
+}
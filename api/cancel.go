@@ -0,0 +1,54 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+)
+
+func (r FunctionCancelRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("request ID is required")
+	}
+
+	return nil
+}
+
+// CancelExecution implements the REST API endpoint for canceling an execution request that is still in progress.
+func (a *API) CancelExecution(ctx echo.Context) error {
+
+	// Get the request ID.
+	var request FunctionCancelRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = request.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing request ID"))
+	}
+
+	err = a.Node.CancelExecution(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not cancel execution: %w", err))
+	}
+
+	res := FunctionCancelResponse{
+		RequestId: request.Id,
+		Code:      codes.OK.String(),
+	}
+
+	// Send the response back.
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,90 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_CancelExecution(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		var canceled string
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(_ context.Context, id string) error {
+			canceled = id
+			return nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionCancelRequest{
+			Id: mocks.GenericString,
+		}
+
+		rec, ctx, err := setupRecorder(cancelEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionCancelResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericString, canceled)
+		require.Equal(t, mocks.GenericString, res.RequestId)
+		require.Equal(t, codes.OK.String(), res.Code)
+	})
+	t.Run("request not running", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.CancelExecutionFunc = func(context.Context, string) error {
+			return blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionCancelRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(cancelEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing request ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.FunctionCancelRequest{}
+
+		_, ctx, err := setupRecorder(cancelEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CancelExecution(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
@@ -1,17 +1,25 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/execute"
 	"github.com/blocklessnetwork/b7s/node/aggregate"
 )
 
+// executionQueueRetryAfter is how long clients are asked to wait before retrying a request that timed out in the execution queue.
+const executionQueueRetryAfter = 10 * time.Second
+
 // ExecuteFunction implements the REST API endpoint for function execution.
 func (a *API) ExecuteFunction(ctx echo.Context) error {
 
@@ -22,37 +30,145 @@ func (a *API) ExecuteFunction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
 	}
 
-	exr := execute.Request{
-		Config:     req.Config,
-		FunctionID: req.FunctionId,
-		Method:     req.Method,
-		Parameters: req.Parameters,
-	}
-
+	exr := req.executionRequest()
 	err = exr.Valid()
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
 	}
 
+	// In async mode, start the execution and return the request ID straight away.
+	if req.Async {
+		id, err := a.Node.ExecuteFunctionAsync(ctx.Request().Context(), exr, req.Topic)
+		if errors.Is(err, blockless.ErrIdempotencyKeyConflict) {
+			return echo.NewHTTPError(http.StatusConflict, err)
+		}
+		if errors.Is(err, blockless.ErrExecutionQueueFull) {
+			return echo.NewHTTPError(http.StatusTooManyRequests, err)
+		}
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not start execution: %w", err))
+		}
+
+		res := ExecutionResponse{
+			Code:      string(codes.Accepted),
+			RequestId: id,
+		}
+
+		return ctx.JSON(http.StatusAccepted, res)
+	}
+
 	// Get the execution result.
 	code, id, results, cluster, err := a.Node.ExecuteFunction(ctx.Request().Context(), exr, req.Topic)
+	if errors.Is(err, blockless.ErrIdempotencyKeyConflict) {
+		return echo.NewHTTPError(http.StatusConflict, err)
+	}
+	if errors.Is(err, blockless.ErrExecutionQueueFull) {
+		return echo.NewHTTPError(http.StatusTooManyRequests, err)
+	}
+	if errors.Is(err, blockless.ErrExecutionQueueTimeout) {
+		return queueTimeoutError(ctx, err)
+	}
 	if err != nil {
 		a.Log.Warn().Str("function", req.FunctionId).Err(err).Msg("node failed to execute function")
 	}
 
+	aggregated, message := aggregateResults(exr.Config.ResultAggregation, results, a.aggregationOptions(ctx.Request().Context(), exr.Config.ResultAggregation, results)...)
+
 	// Transform the node response format to the one returned by the API.
 	res := ExecutionResponse{
-		Code:      string(code),
-		RequestId: id,
-		Results:   aggregate.Aggregate(results),
-		Cluster:   cluster,
+		Code:         string(code),
+		RequestId:    id,
+		Message:      message,
+		Results:      aggregated,
+		Aggregation:  exr.Config.ResultAggregation.Strategy(),
+		Verification: resultVerification(results, cluster),
+		Cluster:      cluster,
 	}
 
 	// Communicate the reason for failure in these cases.
-	if errors.Is(err, blockless.ErrRollCallTimeout) || errors.Is(err, blockless.ErrExecutionNotEnoughNodes) {
+	if errors.Is(err, blockless.ErrRollCallTimeout) ||
+		errors.Is(err, blockless.ErrExecutionNotEnoughNodes) ||
+		errors.Is(err, blockless.ErrNotEnoughMatchingResults) ||
+		errors.Is(err, blockless.ErrExecutionCanceled) {
 		res.Message = err.Error()
 	}
 
 	// Send the response.
 	return ctx.JSON(http.StatusOK, res)
 }
+
+// queueTimeoutError returns the error for requests that timed out waiting for their turn in the execution queue.
+// Client is told when to retry the request.
+func queueTimeoutError(ctx echo.Context, err error) error {
+	ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(executionQueueRetryAfter.Seconds())))
+	return echo.NewHTTPError(http.StatusServiceUnavailable, err)
+}
+
+// executionRequest converts the API request to the format used by the node.
+func (r ExecutionRequest) executionRequest() execute.Request {
+
+	req := execute.Request{
+		Config:     r.Config,
+		FunctionID: r.FunctionId,
+		Method:     r.Method,
+		Parameters: r.Parameters,
+
+		IdempotencyKey: r.IdempotencyKey,
+	}
+
+	return req
+}
+
+// aggregateResults combines the results using the requested aggregation strategy.
+// If the results could not be combined, the reason is returned as the message.
+func aggregateResults(cfg execute.ResultAggregation, results execute.ResultMap, opts ...aggregate.Option) (aggregate.Results, string) {
+
+	aggregated, err := aggregate.AggregateWith(cfg, results, opts...)
+	if err != nil {
+		return nil, fmt.Sprintf("could not aggregate results: %s", err)
+	}
+
+	return aggregated, ""
+}
+
+// aggregationOptions returns the options required by the aggregation strategy.
+// For the weighted majority vote, results are weighted by the reputation of the peers that returned them.
+func (a *API) aggregationOptions(ctx context.Context, cfg execute.ResultAggregation, results execute.ResultMap) []aggregate.Option {
+
+	if cfg.Strategy() != execute.AggregateWeightedMajority || len(results) == 0 {
+		return nil
+	}
+
+	scores := make(map[peer.ID]float64, len(results))
+	for id := range results {
+
+		reputation, err := a.Node.PeerReputation(ctx, id)
+		if err != nil && !errors.Is(err, blockless.ErrNotFound) {
+			a.Log.Warn().Err(err).Stringer("peer", id).Msg("could not retrieve peer reputation")
+		}
+
+		// Peers without a reputation get a neutral score.
+		scores[id] = reputation.Score()
+	}
+
+	weight := func(id peer.ID) float64 {
+		return scores[id]
+	}
+
+	return []aggregate.Option{aggregate.WithWeights(weight)}
+}
+
+// resultVerification summarizes the outcome of the signature verification of the results sent by the nodes.
+func resultVerification(results execute.ResultMap, cluster execute.Cluster) ResultVerification {
+
+	switch {
+	case len(cluster.Unverified) == 0 && len(results) == 0:
+		return ""
+	case len(cluster.Unverified) == 0:
+		return Verified
+	case len(results) == 0:
+		return Failed
+	default:
+		return Partial
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/execute"
 	"github.com/blocklessnetwork/b7s/testing/mocks"
@@ -72,6 +73,37 @@ func TestAPI_Execute(t *testing.T) {
 	require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
 }
 
+func TestAPI_Execute_Async(t *testing.T) {
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		require.FailNow(t, "synchronous execution unexpectedly invoked")
+		return codes.Error, "", nil, execute.Cluster{}, nil
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	req := api.ExecutionRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Async:      true,
+	}
+
+	rec, ctx, err := setupRecorder(executeEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.NoError(t, err)
+
+	var res api.ExecutionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+	require.Equal(t, codes.Accepted.String(), res.Code)
+	require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+	require.Empty(t, res.Results)
+}
+
 func TestAPI_Execute_HandlesErrors(t *testing.T) {
 
 	executionResult := execute.Result{
@@ -118,6 +150,118 @@ func TestAPI_Execute_HandlesErrors(t *testing.T) {
 	require.Equal(t, mocks.GenericPeerID, res.Results[0].Peers[0])
 }
 
+func TestAPI_Execute_IdempotencyKey(t *testing.T) {
+
+	const key = "dummy-idempotency-key"
+
+	t.Run("key is passed to the node", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionFunc = func(_ context.Context, req execute.Request, _ string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+			require.Equal(t, key, req.IdempotencyKey)
+			return codes.OK, mocks.GenericUUID.String(), nil, execute.Cluster{}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionRequest{
+			FunctionId:     mocks.GenericExecutionRequest.FunctionID,
+			Method:         mocks.GenericExecutionRequest.Method,
+			IdempotencyKey: key,
+		}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+	})
+	t.Run("key reused for a different request", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionAsyncFunc = func(context.Context, execute.Request, string) (string, error) {
+			return "", blockless.ErrIdempotencyKeyConflict
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ExecutionRequest{
+			FunctionId:     mocks.GenericExecutionRequest.FunctionID,
+			Method:         mocks.GenericExecutionRequest.Method,
+			Async:          true,
+			IdempotencyKey: key,
+		}
+
+		_, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusConflict, echoErr.Code)
+	})
+}
+
+func TestAPI_Execute_QueueFull(t *testing.T) {
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		return codes.TooManyRequests, "", nil, execute.Cluster{}, blockless.ErrExecutionQueueFull
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	req := api.ExecutionRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+	}
+
+	_, ctx, err := setupRecorder(executeEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.Error(t, err)
+
+	echoErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+
+	require.Equal(t, http.StatusTooManyRequests, echoErr.Code)
+}
+
+func TestAPI_Execute_QueueTimeout(t *testing.T) {
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+		return codes.NotAvailable, "", nil, execute.Cluster{}, blockless.ErrExecutionQueueTimeout
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	req := api.ExecutionRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+	}
+
+	rec, ctx, err := setupRecorder(executeEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunction(ctx)
+	require.Error(t, err)
+
+	echoErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+
+	require.Equal(t, http.StatusServiceUnavailable, echoErr.Code)
+	require.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+}
+
 func TestAPI_Execute_HandlesMalformedRequests(t *testing.T) {
 
 	api := setupAPI(t)
@@ -189,3 +333,110 @@ func TestAPI_Execute_HandlesMalformedRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestAPI_Execute_ResultAggregation(t *testing.T) {
+	t.Run("strategy is reported", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+
+			res := execute.ResultMap{
+				mocks.GenericPeerIDs[0]: execute.NodeResult{Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "1"}}},
+				mocks.GenericPeerIDs[1]: execute.NodeResult{Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "3"}}},
+			}
+
+			return codes.OK, mocks.GenericUUID.String(), res, execute.Cluster{}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.ResultAggregation = execute.ResultAggregation{
+			Enable: true,
+			Type:   execute.AggregateMean,
+		}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, execute.AggregateMean, res.Aggregation)
+		require.Len(t, res.Results, 1)
+		require.Equal(t, "2", res.Results[0].Result.Stdout)
+	})
+	t.Run("results weighted by peer reputation", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			trusted    = mocks.GenericPeerIDs[0]
+			untrusted1 = mocks.GenericPeerIDs[1]
+			untrusted2 = mocks.GenericPeerIDs[2]
+		)
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteFunctionFunc = func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
+
+			res := execute.ResultMap{
+				trusted:    execute.NodeResult{Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "yes"}}},
+				untrusted1: execute.NodeResult{Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "no"}}},
+				untrusted2: execute.NodeResult{Result: execute.Result{Code: codes.OK, Result: execute.RuntimeOutput{Stdout: "no"}}},
+			}
+
+			return codes.OK, mocks.GenericUUID.String(), res, execute.Cluster{}, nil
+		}
+		node.PeerReputationFunc = func(_ context.Context, id peer.ID) (blockless.PeerReputation, error) {
+			if id == trusted {
+				return blockless.PeerReputation{Peer: id, Succeeded: 100, Agreed: 100}, nil
+			}
+			return blockless.PeerReputation{Peer: id, Failed: 20, Disagreed: 20}, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.ResultAggregation = execute.ResultAggregation{
+			Enable: true,
+			Type:   execute.AggregateWeightedMajority,
+		}
+
+		rec, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.NoError(t, err)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, execute.AggregateWeightedMajority, res.Aggregation)
+		require.Len(t, res.Results, 1)
+		require.Equal(t, "yes", res.Results[0].Result.Stdout)
+	})
+	t.Run("unknown aggregation type", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := mocks.GenericExecutionRequest
+		req.Config.ResultAggregation = execute.ResultAggregation{
+			Enable: true,
+			Type:   "mode",
+		}
+
+		_, ctx, err := setupRecorder(executeEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListFunctions implements the REST API endpoint for listing functions workers reported having installed.
+func (a *API) ListFunctions(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, a.Node.InstalledFunctions(ctx.Request().Context()))
+}
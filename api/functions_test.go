@@ -0,0 +1,28 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ListFunctions(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	rec, ctx, err := setupRecorder(functionsEndpoint, nil)
+	require.NoError(t, err)
+
+	err = srv.ListFunctions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var functions []blockless.InstalledFunction
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &functions))
+	require.Equal(t, []blockless.InstalledFunction{mocks.GenericInstalledFunction}, functions)
+}
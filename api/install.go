@@ -7,6 +7,8 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
 )
 
 func (r FunctionInstallRequest) Valid() error {
@@ -32,13 +34,51 @@ func (a *API) InstallFunction(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
 	}
 
-	err = a.Node.PublishFunctionInstall(ctx.Request().Context(), req.Uri, req.Cid, req.Topic)
+	job, err := a.Node.PublishFunctionInstall(ctx.Request().Context(), req.Uri, req.Cid, req.Topic)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("function installation failed: %w", err))
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"code": strconv.Itoa(http.StatusOK),
-	})
+	res := FunctionInstallResponse{
+		Code: strconv.Itoa(http.StatusOK),
+		Id:   job.ID,
+		Cid:  job.CID,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (r FunctionInstallStatusRequest) Valid() error {
+
+	if r.Cid == "" {
+		return errors.New("function CID is required")
+	}
+
+	return nil
+}
+
+// InstallStatus implements the REST API endpoint for retrieving the install progress of a function.
+func (a *API) InstallStatus(ctx echo.Context) error {
+
+	var req FunctionInstallStatusRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	job, err := a.Node.InstallStatus(ctx.Request().Context(), req.Cid)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve install status: %w", err))
+	}
 
+	return ctx.JSON(http.StatusOK, job)
 }
@@ -2,6 +2,7 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/testing/mocks"
 )
 
@@ -30,6 +32,76 @@ func TestAPI_FunctionInstall(t *testing.T) {
 		require.NoError(t, err)
 
 		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionInstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, mocks.GenericInstallJob.ID, res.Id)
+		require.Equal(t, mocks.GenericInstallJob.CID, res.Cid)
+	})
+}
+
+func TestAPI_InstallStatus(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.InstallStatusFunc = func(_ context.Context, cid string) (blockless.FunctionJob, error) {
+			require.Equal(t, mocks.GenericInstallJob.CID, cid)
+			return mocks.GenericInstallJob, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionInstallStatusRequest{
+			Cid: mocks.GenericInstallJob.CID,
+		}
+
+		rec, ctx, err := setupRecorder(installStatusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.InstallStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var job blockless.FunctionJob
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &job))
+		require.Equal(t, mocks.GenericInstallJob, job)
+	})
+	t.Run("no install job", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.InstallStatusFunc = func(context.Context, string) (blockless.FunctionJob, error) {
+			return blockless.FunctionJob{}, blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionInstallStatusRequest{
+			Cid: mocks.GenericInstallJob.CID,
+		}
+
+		rec, ctx, err := setupRecorder(installStatusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.InstallStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing CID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(installStatusEndpoint, api.FunctionInstallStatusRequest{})
+		require.NoError(t, err)
+
+		err = srv.InstallStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
 	})
 }
 
@@ -59,8 +131,8 @@ func TestAPI_FunctionInstall_HandlesErrors(t *testing.T) {
 		t.Parallel()
 
 		node := mocks.BaselineNode(t)
-		node.PublishFunctionInstallFunc = func(context.Context, string, string, string) error {
-			return mocks.GenericError
+		node.PublishFunctionInstallFunc = func(context.Context, string, string, string) (blockless.FunctionJob, error) {
+			return blockless.FunctionJob{}, mocks.GenericError
 		}
 
 		srv := api.New(mocks.NoopLogger, node)
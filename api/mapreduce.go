@@ -0,0 +1,79 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// ExecuteFunctionMapReduce implements the REST API endpoint for executing a function over a sharded input.
+func (a *API) ExecuteFunctionMapReduce(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req MapReduceExecutionRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	mr := req.mapReduceRequest()
+	err = mr.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	code, id, result, err := a.Node.ExecuteMapReduce(ctx.Request().Context(), mr, req.Topic)
+	if errors.Is(err, blockless.ErrExecutionQueueFull) {
+		return echo.NewHTTPError(http.StatusTooManyRequests, err)
+	}
+	if errors.Is(err, blockless.ErrExecutionQueueTimeout) {
+		return queueTimeoutError(ctx, err)
+	}
+	if err != nil {
+		a.Log.Warn().Str("function", req.FunctionId).Str("reduce_function", req.Reduce.FunctionID).Err(err).Msg("node failed to execute map-reduce")
+	}
+
+	aggregated, message := aggregateResults(mr.Reduce.Config.ResultAggregation, result.Results, a.aggregationOptions(ctx.Request().Context(), mr.Reduce.Config.ResultAggregation, result.Results)...)
+
+	res := MapReduceExecutionResponse{
+		Code:        string(code),
+		RequestId:   id,
+		Message:     message,
+		Shards:      result.Shards,
+		Results:     aggregated,
+		Aggregation: mr.Reduce.Config.ResultAggregation.Strategy(),
+		Cluster:     result.Cluster,
+	}
+
+	// Communicate the reason for failure in these cases.
+	if errors.Is(err, blockless.ErrRollCallTimeout) ||
+		errors.Is(err, blockless.ErrExecutionNotEnoughNodes) ||
+		errors.Is(err, blockless.ErrNotEnoughMatchingResults) ||
+		errors.Is(err, blockless.ErrShardFailed) {
+		res.Message = err.Error()
+	}
+
+	// Send the response.
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// mapReduceRequest converts the API request to the format used by the node.
+func (r MapReduceExecutionRequest) mapReduceRequest() execute.MapReduceRequest {
+
+	req := execute.MapReduceRequest{
+		FunctionID: r.FunctionId,
+		Method:     r.Method,
+		Parameters: r.Parameters,
+		Config:     r.Config,
+		Input:      r.Input,
+		Shards:     r.Shards,
+		Reduce:     r.Reduce,
+	}
+
+	return req
+}
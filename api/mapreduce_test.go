@@ -0,0 +1,108 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecuteMapReduce(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	req := api.MapReduceExecutionRequest{
+		FunctionId: mocks.GenericExecutionRequest.FunctionID,
+		Method:     mocks.GenericExecutionRequest.Method,
+		Input:      execute.MapInput{Stdin: "first\nsecond\nthird"},
+		Shards:     3,
+		Reduce: execute.ReduceFunction{
+			FunctionID: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		},
+	}
+
+	rec, ctx, err := setupRecorder(mapReduceEndpoint, req)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunctionMapReduce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var res api.MapReduceExecutionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	require.Equal(t, mocks.GenericUUID.String(), res.RequestId)
+	require.Len(t, res.Shards, req.Shards)
+	for i, shard := range res.Shards {
+		require.Equal(t, i, shard.Index)
+	}
+
+	require.Len(t, res.Results, 1)
+	require.Equal(t, mocks.GenericExecutionResult.Result, res.Results[0].Result)
+}
+
+func TestAPI_ExecuteMapReduce_HandlesErrors(t *testing.T) {
+	t.Run("missing reduce function", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.MapReduceExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Input:      execute.MapInput{Stdin: "first\nsecond"},
+		}
+
+		_, ctx, err := setupRecorder(mapReduceEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionMapReduce(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("shard failed", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteMapReduceFunc = func(context.Context, execute.MapReduceRequest, string) (codes.Code, string, execute.MapReduceResult, error) {
+			return codes.PartialContent, mocks.GenericUUID.String(), execute.MapReduceResult{}, blockless.ErrShardFailed
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.MapReduceExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+			Input:      execute.MapInput{Stdin: "first\nsecond"},
+			Reduce: execute.ReduceFunction{
+				FunctionID: mocks.GenericExecutionRequest.FunctionID,
+				Method:     mocks.GenericExecutionRequest.Method,
+			},
+		}
+
+		rec, ctx, err := setupRecorder(mapReduceEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteFunctionMapReduce(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.MapReduceExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, codes.PartialContent.String(), res.Code)
+		require.Equal(t, blockless.ErrShardFailed.Error(), res.Message)
+	})
+}
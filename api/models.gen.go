@@ -4,19 +4,82 @@
 package api
 
 import (
+	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/execute"
 	"github.com/blocklessnetwork/b7s/node/aggregate"
 )
 
+// Defines values for ResultVerification.
+const (
+	Failed   ResultVerification = "failed"
+	Partial  ResultVerification = "partial"
+	Verified ResultVerification = "verified"
+)
+
 // AggregatedResult Result of an Execution Request
 type AggregatedResult = aggregate.Result
 
 // AggregatedResults List of unique results of the Execution Request
 type AggregatedResults = aggregate.Results
 
+// AggregationType How the execution results are combined:
+//   - `exact` - results with identical output are grouped
+//   - `majority` - result with identical output from enough nodes, as determined by the agreement ratio
+//   - `median` - median of the numeric outputs
+//   - `mean` - mean of the numeric outputs
+//   - `json-field` - results are grouped by the value of a field in the JSON output
+//   - `fastest` - successful result that took the least time to execute
+//   - `weighted-majority` - like `majority`, but results are weighted by the reputation of the nodes that returned them
+type AggregationType = execute.AggregationType
+
 // AttributeAttestors Require specific attestors as vouchers
 type AttributeAttestors = execute.AttributeAttestors
 
+// AttributeCondition Condition that a single Node attribute should satisfy
+type AttributeCondition = execute.AttributeCondition
+
+// BatchExecutionItem A single set of inputs for the Blockless Function. Inputs set here override the ones set in the config
+type BatchExecutionItem = execute.BatchItem
+
+// BatchExecutionRequest defines model for BatchExecutionRequest.
+type BatchExecutionRequest struct {
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// FunctionId CID of the function
+	FunctionId string `json:"function_id"`
+
+	// Items Sets of inputs for the Blockless Function. The function is executed once for each item
+	Items []BatchExecutionItem `json:"items"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// BatchExecutionResponse defines model for BatchExecutionResponse.
+type BatchExecutionResponse struct {
+	// Code Status of the batch execution
+	Code string `json:"code,omitempty"`
+
+	// Message If the batch execution failed, this message might have more info about the error
+	Message string `json:"message,omitempty"`
+
+	// RequestId ID of the batch Execution Request
+	RequestId string `json:"request_id,omitempty"`
+
+	// Results Results of the batch items, in the order of the items in the request
+	Results []BatchItemResult `json:"results,omitempty"`
+}
+
+// BatchItemResult Result of a single batch item
+type BatchItemResult = execute.BatchItemResult
+
+// ConnectedPeer Peer the node is currently connected to
+type ConnectedPeer = blockless.ConnectedPeer
+
 // ExecutionConfig Configuration options for the Execution Request
 type ExecutionConfig = execute.Config
 
@@ -25,12 +88,19 @@ type ExecutionParameter = execute.Parameter
 
 // ExecutionRequest defines model for ExecutionRequest.
 type ExecutionRequest struct {
+	// Async If set, the request ID is returned immediately and the Execution Request is processed in the background
+	Async bool `json:"async,omitempty"`
+
 	// Config Configuration options for the Execution Request
 	Config ExecutionConfig `json:"config,omitempty"`
 
 	// FunctionId CID of the function
 	FunctionId string `json:"function_id"`
 
+	// IdempotencyKey Optional key identifying the Execution Request. Repeated submissions with the same key, made while the execution
+	// is in progress or shortly after, do not start a new execution but return the outcome of the original one
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+
 	// Method Name of the WASM file to execute
 	Method string `json:"method"`
 
@@ -41,8 +111,21 @@ type ExecutionRequest struct {
 	Topic string `json:"topic,omitempty"`
 }
 
+// ExecutionRequestTemplate Execution Request issued on each run of the schedule
+type ExecutionRequestTemplate = execute.Request
+
 // ExecutionResponse defines model for ExecutionResponse.
 type ExecutionResponse struct {
+	// Aggregation How the execution results are combined:
+	//   - `exact` - results with identical output are grouped
+	//   - `majority` - result with identical output from enough nodes, as determined by the agreement ratio
+	//   - `median` - median of the numeric outputs
+	//   - `mean` - mean of the numeric outputs
+	//   - `json-field` - results are grouped by the value of a field in the JSON output
+	//   - `fastest` - successful result that took the least time to execute
+	//   - `weighted-majority` - like `majority`, but results are weighted by the reputation of the nodes that returned them
+	Aggregation AggregationType `json:"aggregation,omitempty"`
+
 	// Cluster Information about the cluster of nodes that executed this request
 	Cluster NodeCluster `json:"cluster,omitempty"`
 
@@ -57,11 +140,59 @@ type ExecutionResponse struct {
 
 	// Results List of unique results of the Execution Request
 	Results AggregatedResults `json:"results,omitempty"`
+
+	// Verification Outcome of the signature verification of the results sent by the Nodes:
+	//   - `verified` - all received results were verified
+	//   - `partial` - some results failed verification and were dropped
+	//   - `failed` - all received results failed verification and were dropped
+	Verification ResultVerification `json:"verification,omitempty"`
 }
 
 // ExecutionResult Actual outputs of the execution, like Standard Output, Standard Error, Exit Code etc..
 type ExecutionResult = execute.RuntimeOutput
 
+// ExecutionResultEvent Worker Node sent its execution result
+type ExecutionResultEvent struct {
+	// Code Status of the execution on the Worker Node
+	Code string `json:"code,omitempty"`
+
+	// Peer ID of the Worker Node
+	Peer string `json:"peer,omitempty"`
+
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+
+	// Result Actual outputs of the execution, like Standard Output, Standard Error, Exit Code etc..
+	Result ExecutionResult `json:"result,omitempty"`
+}
+
+// ExecutionRollCallEvent Worker Node reported for the roll call
+type ExecutionRollCallEvent struct {
+	// Peer ID of the Worker Node
+	Peer string `json:"peer,omitempty"`
+
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+}
+
+// ExecutionState Stage the Execution Request is in
+type ExecutionState = execute.State
+
+// FunctionCancelRequest Cancel an Execution Request, identified by the request ID
+type FunctionCancelRequest struct {
+	// Id ID of the Execution Request
+	Id string `json:"id"`
+}
+
+// FunctionCancelResponse Outcome of the cancellation of an Execution Request
+type FunctionCancelResponse struct {
+	// Code Status of the cancellation
+	Code string `json:"code,omitempty"`
+
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+}
+
 // FunctionInstallRequest defines model for FunctionInstallRequest.
 type FunctionInstallRequest struct {
 	// Cid CID of the function
@@ -74,9 +205,23 @@ type FunctionInstallRequest struct {
 
 // FunctionInstallResponse defines model for FunctionInstallResponse.
 type FunctionInstallResponse struct {
+	// Cid CID of the function, used to check the install status
+	Cid  string `json:"cid,omitempty"`
 	Code string `json:"code,omitempty"`
+
+	// Id ID of the install job
+	Id string `json:"id,omitempty"`
 }
 
+// FunctionInstallStatusRequest defines model for FunctionInstallStatusRequest.
+type FunctionInstallStatusRequest struct {
+	// Cid CID of the function
+	Cid string `json:"cid"`
+}
+
+// FunctionJob Installation or removal of a function published to the workers in a topic. Only workers that responded are listed
+type FunctionJob = blockless.FunctionJob
+
 // FunctionResultRequest Get the result of an Execution Request, identified by the request ID
 type FunctionResultRequest struct {
 	// Id ID of the Execution Request
@@ -86,11 +231,118 @@ type FunctionResultRequest struct {
 // FunctionResultResponse defines model for FunctionResultResponse.
 type FunctionResultResponse = ExecutionResponse
 
+// FunctionStatusRequest Get the status of an Execution Request, identified by the request ID
+type FunctionStatusRequest struct {
+	// Id ID of the Execution Request
+	Id string `json:"id"`
+}
+
+// FunctionStatusResponse Status of an Execution Request
+type FunctionStatusResponse struct {
+	// RequestId ID of the Execution Request
+	RequestId string `json:"request_id,omitempty"`
+
+	// State Stage the Execution Request is in
+	State ExecutionState `json:"state,omitempty"`
+}
+
+// FunctionUninstallRequest defines model for FunctionUninstallRequest.
+type FunctionUninstallRequest struct {
+	// Cid CID of the function
+	Cid string `json:"cid"`
+
+	// Topic In a scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// FunctionUninstallResponse defines model for FunctionUninstallResponse.
+type FunctionUninstallResponse struct {
+	// Cid CID of the function, used to check the uninstall status
+	Cid  string `json:"cid,omitempty"`
+	Code string `json:"code,omitempty"`
+
+	// Id ID of the uninstall job
+	Id string `json:"id,omitempty"`
+}
+
+// FunctionUninstallStatusRequest defines model for FunctionUninstallStatusRequest.
+type FunctionUninstallStatusRequest struct {
+	// Cid CID of the function
+	Cid string `json:"cid"`
+}
+
+// FunctionWorker Worker that reported having a function installed
+type FunctionWorker = blockless.FunctionWorker
+
 // HealthStatus Node status
 type HealthStatus struct {
 	Code string `json:"code,omitempty"`
 }
 
+// InstalledFunction Function along with the workers that reported having it installed
+type InstalledFunction = blockless.InstalledFunction
+
+// MapInput Input split into shards. Either standard input or parameters should be set
+type MapInput = execute.MapInput
+
+// MapReduceExecutionRequest defines model for MapReduceExecutionRequest.
+type MapReduceExecutionRequest struct {
+	// Config Configuration options for the Execution Request
+	Config ExecutionConfig `json:"config,omitempty"`
+
+	// FunctionId CID of the function processing the shards
+	FunctionId string `json:"function_id"`
+
+	// Input Input split into shards. Either standard input or parameters should be set
+	Input MapInput `json:"input"`
+
+	// Method Name of the WASM file to execute
+	Method string `json:"method"`
+
+	// Parameters CLI arguments passed to each shard, before the sharded ones
+	Parameters []ExecutionParameter `json:"parameters,omitempty"`
+
+	// Reduce Function executed over the shard outputs
+	Reduce ReduceFunction `json:"reduce"`
+
+	// Shards Number of shards the input is split into. If not set, the input is split across all workers selected for the execution
+	Shards int `json:"shards,omitempty"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// MapReduceExecutionResponse defines model for MapReduceExecutionResponse.
+type MapReduceExecutionResponse struct {
+	// Aggregation How the execution results are combined:
+	//   - `exact` - results with identical output are grouped
+	//   - `majority` - result with identical output from enough nodes, as determined by the agreement ratio
+	//   - `median` - median of the numeric outputs
+	//   - `mean` - mean of the numeric outputs
+	//   - `json-field` - results are grouped by the value of a field in the JSON output
+	//   - `fastest` - successful result that took the least time to execute
+	//   - `weighted-majority` - like `majority`, but results are weighted by the reputation of the nodes that returned them
+	Aggregation AggregationType `json:"aggregation,omitempty"`
+
+	// Cluster Information about the cluster of nodes that executed this request
+	Cluster NodeCluster `json:"cluster,omitempty"`
+
+	// Code Status of the map-reduce execution
+	Code string `json:"code,omitempty"`
+
+	// Message If the map-reduce execution failed, this message might have more info about the error
+	Message string `json:"message,omitempty"`
+
+	// RequestId ID of the map-reduce Execution Request
+	RequestId string `json:"request_id,omitempty"`
+
+	// Results List of unique results of the Execution Request
+	Results AggregatedResults `json:"results,omitempty"`
+
+	// Shards Results of the shards, in shard order
+	Shards []BatchItemResult `json:"shards,omitempty"`
+}
+
 // NamedValue A key-value pair
 type NamedValue = execute.EnvVar
 
@@ -100,17 +352,153 @@ type NodeAttributes = execute.Attributes
 // NodeCluster Information about the cluster of nodes that executed this request
 type NodeCluster = execute.Cluster
 
-// ResultAggregation defines model for ResultAggregation.
+// NodeInfo Information about the node
+type NodeInfo = blockless.NodeInfo
+
+// Peer Peer seen on the network
+type Peer = blockless.Peer
+
+// PeerJobStatus Install or uninstall outcome reported by a worker
+type PeerJobStatus = blockless.PeerJobStatus
+
+// PeerReputation Track record of a peer, as observed by the head node
+type PeerReputation = blockless.PeerReputation
+
+// ReduceFunction Function executed over the shard outputs
+type ReduceFunction = execute.ReduceFunction
+
+// ReputationRequest defines model for ReputationRequest.
+type ReputationRequest struct {
+	// Peer ID of the peer
+	Peer string `json:"peer"`
+}
+
+// ResultAggregation How the execution results from multiple nodes are combined. Unless enabled, identical results are grouped
 type ResultAggregation = execute.ResultAggregation
 
+// ResultNormalization Determines which results are considered equivalent. Applies even if aggregation is not enabled
+type ResultNormalization = execute.ResultNormalization
+
+// ResultVerification Outcome of the signature verification of the results sent by the Nodes:
+//   - `verified` - all received results were verified
+//   - `partial` - some results failed verification and were dropped
+//   - `failed` - all received results failed verification and were dropped
+type ResultVerification string
+
 // RuntimeConfig Configuration options for the Blockless Runtime
 type RuntimeConfig = execute.BLSRuntimeConfig
 
+// Schedule Schedule for recurring execution of a Blockless Function
+type Schedule = blockless.ScheduleRecord
+
+// ScheduleCreateRequest defines model for ScheduleCreateRequest.
+type ScheduleCreateRequest struct {
+	// Cron Cron expression determining when the function is executed - minute, hour, day of month, month and day of week.
+	// Predefined schedules like `@hourly` and fixed intervals like `@every 30s` are supported too. Times are in UTC
+	Cron string `json:"cron"`
+
+	// Request Execution Request issued on each run of the schedule
+	Request ExecutionRequestTemplate `json:"request"`
+
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+}
+
+// ScheduleRemoveResponse defines model for ScheduleRemoveResponse.
+type ScheduleRemoveResponse struct {
+	// Code Status of the request
+	Code string `json:"code,omitempty"`
+
+	// Id ID of the removed schedule
+	Id string `json:"id,omitempty"`
+}
+
+// ScheduleRequest defines model for ScheduleRequest.
+type ScheduleRequest struct {
+	// Id ID of the schedule
+	Id string `json:"id"`
+}
+
+// ScheduleRun A single run of a schedule
+type ScheduleRun = blockless.ScheduleRun
+
+// Workflow Directed acyclic graph of Blockless Function executions
+type Workflow = execute.Workflow
+
+// WorkflowRequest defines model for WorkflowRequest.
+type WorkflowRequest struct {
+	// Topic In the scenario where workers form subgroups, you can target a specific subgroup by specifying its identifier
+	Topic string `json:"topic,omitempty"`
+
+	// Workflow Directed acyclic graph of Blockless Function executions
+	Workflow Workflow `json:"workflow"`
+}
+
+// WorkflowResponse defines model for WorkflowResponse.
+type WorkflowResponse struct {
+	// Id ID of the workflow
+	Id string `json:"id,omitempty"`
+}
+
+// WorkflowStatus State of a workflow along with the progress of its steps
+type WorkflowStatus = blockless.WorkflowRecord
+
+// WorkflowStatusRequest defines model for WorkflowStatusRequest.
+type WorkflowStatusRequest struct {
+	// Id ID of the workflow
+	Id string `json:"id"`
+}
+
+// WorkflowStep A single function execution within a workflow
+type WorkflowStep = execute.WorkflowStep
+
 // ExecuteFunctionJSONRequestBody defines body for ExecuteFunction for application/json ContentType.
 type ExecuteFunctionJSONRequestBody = ExecutionRequest
 
+// ExecuteFunctionBatchJSONRequestBody defines body for ExecuteFunctionBatch for application/json ContentType.
+type ExecuteFunctionBatchJSONRequestBody = BatchExecutionRequest
+
+// ExecuteFunctionMapReduceJSONRequestBody defines body for ExecuteFunctionMapReduce for application/json ContentType.
+type ExecuteFunctionMapReduceJSONRequestBody = MapReduceExecutionRequest
+
+// ExecuteFunctionStreamJSONRequestBody defines body for ExecuteFunctionStream for application/json ContentType.
+type ExecuteFunctionStreamJSONRequestBody = ExecutionRequest
+
 // InstallFunctionJSONRequestBody defines body for InstallFunction for application/json ContentType.
 type InstallFunctionJSONRequestBody = FunctionInstallRequest
 
+// InstallStatusJSONRequestBody defines body for InstallStatus for application/json ContentType.
+type InstallStatusJSONRequestBody = FunctionInstallStatusRequest
+
+// CancelExecutionJSONRequestBody defines body for CancelExecution for application/json ContentType.
+type CancelExecutionJSONRequestBody = FunctionCancelRequest
+
 // ExecutionResultJSONRequestBody defines body for ExecutionResult for application/json ContentType.
 type ExecutionResultJSONRequestBody = FunctionResultRequest
+
+// ExecutionStatusJSONRequestBody defines body for ExecutionStatus for application/json ContentType.
+type ExecutionStatusJSONRequestBody = FunctionStatusRequest
+
+// UninstallFunctionJSONRequestBody defines body for UninstallFunction for application/json ContentType.
+type UninstallFunctionJSONRequestBody = FunctionUninstallRequest
+
+// UninstallStatusJSONRequestBody defines body for UninstallStatus for application/json ContentType.
+type UninstallStatusJSONRequestBody = FunctionUninstallStatusRequest
+
+// GetReputationJSONRequestBody defines body for GetReputation for application/json ContentType.
+type GetReputationJSONRequestBody = ReputationRequest
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = ScheduleCreateRequest
+
+// GetScheduleJSONRequestBody defines body for GetSchedule for application/json ContentType.
+type GetScheduleJSONRequestBody = ScheduleRequest
+
+// RemoveScheduleJSONRequestBody defines body for RemoveSchedule for application/json ContentType.
+type RemoveScheduleJSONRequestBody = ScheduleRequest
+
+// ExecuteWorkflowJSONRequestBody defines body for ExecuteWorkflow for application/json ContentType.
+type ExecuteWorkflowJSONRequestBody = WorkflowRequest
+
+// WorkflowStatusJSONRequestBody defines body for WorkflowStatus for application/json ContentType.
+type WorkflowStatusJSONRequestBody = WorkflowStatusRequest
@@ -3,12 +3,36 @@ package api
 import (
 	"context"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/execute"
 )
 
 type Node interface {
 	ExecuteFunction(ctx context.Context, req execute.Request, subgroup string) (code codes.Code, requestID string, results execute.ResultMap, peers execute.Cluster, err error)
-	ExecutionResult(id string) (execute.ResultMap, bool)
-	PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error
+	ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string) (requestID string, err error)
+	ExecuteFunctionStream(ctx context.Context, req execute.Request, subgroup string) (requestID string, events <-chan execute.Event, err error)
+	ExecuteBatch(ctx context.Context, req execute.BatchRequest, subgroup string) (code codes.Code, requestID string, results []execute.BatchItemResult, err error)
+	ExecuteMapReduce(ctx context.Context, req execute.MapReduceRequest, subgroup string) (code codes.Code, requestID string, result execute.MapReduceResult, err error)
+	ExecutionResult(ctx context.Context, id string) (blockless.ExecutionRecord, error)
+	ExecutionStatus(ctx context.Context, id string) (execute.State, error)
+	CancelExecution(ctx context.Context, id string) error
+	PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) (blockless.FunctionJob, error)
+	InstallStatus(ctx context.Context, cid string) (blockless.FunctionJob, error)
+	PublishFunctionUninstall(ctx context.Context, cid string, subgroup string) (blockless.FunctionJob, error)
+	UninstallStatus(ctx context.Context, cid string) (blockless.FunctionJob, error)
+	CreateSchedule(ctx context.Context, cron string, req execute.Request, subgroup string) (blockless.ScheduleRecord, error)
+	Schedule(ctx context.Context, id string) (blockless.ScheduleRecord, error)
+	Schedules(ctx context.Context) ([]blockless.ScheduleRecord, error)
+	RemoveSchedule(ctx context.Context, id string) error
+	ExecuteWorkflow(ctx context.Context, workflow execute.Workflow, subgroup string) (id string, err error)
+	Workflow(ctx context.Context, id string) (blockless.WorkflowRecord, error)
+	PeerReputation(ctx context.Context, id peer.ID) (blockless.PeerReputation, error)
+	PeerReputations(ctx context.Context) ([]blockless.PeerReputation, error)
+	NodeInfo(ctx context.Context) blockless.NodeInfo
+	KnownPeers(ctx context.Context) ([]blockless.Peer, error)
+	ConnectedPeers(ctx context.Context) []blockless.ConnectedPeer
+	InstalledFunctions(ctx context.Context) []blockless.InstalledFunction
 }
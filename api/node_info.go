@@ -0,0 +1,12 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetNodeInfo implements the REST API endpoint for retrieving information about the node.
+func (a *API) GetNodeInfo(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, a.Node.NodeInfo(ctx.Request().Context()))
+}
@@ -0,0 +1,28 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_GetNodeInfo(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	rec, ctx, err := setupRecorder(nodeInfoEndpoint, nil)
+	require.NoError(t, err)
+
+	err = srv.GetNodeInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var info blockless.NodeInfo
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+	require.Equal(t, mocks.GenericNodeInfo, info)
+}
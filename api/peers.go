@@ -0,0 +1,24 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListPeers implements the REST API endpoint for listing peers the node has seen.
+func (a *API) ListPeers(ctx echo.Context) error {
+
+	peers, err := a.Node.KnownPeers(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve peers: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, peers)
+}
+
+// ListConnectedPeers implements the REST API endpoint for listing peers the node is currently connected to.
+func (a *API) ListConnectedPeers(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, a.Node.ConnectedPeers(ctx.Request().Context()))
+}
@@ -0,0 +1,72 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ListPeers(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		rec, ctx, err := setupRecorder(peersEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListPeers(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var peers []blockless.Peer
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peers))
+		require.Len(t, peers, 1)
+		require.Equal(t, mocks.GenericPeer.ID, peers[0].ID)
+		require.Equal(t, mocks.GenericPeer.MultiAddr, peers[0].MultiAddr)
+	})
+	t.Run("node fails to retrieve peers", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.KnownPeersFunc = func(context.Context) ([]blockless.Peer, error) {
+			return nil, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(peersEndpoint, nil)
+		require.NoError(t, err)
+
+		err = srv.ListPeers(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_ListConnectedPeers(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	rec, ctx, err := setupRecorder(connectedPeersEndpoint, nil)
+	require.NoError(t, err)
+
+	err = srv.ListConnectedPeers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var peers []blockless.ConnectedPeer
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peers))
+	require.Equal(t, []blockless.ConnectedPeer{mocks.GenericConnectedPeer}, peers)
+}
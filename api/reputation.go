@@ -0,0 +1,66 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+func (r ReputationRequest) Valid() error {
+
+	if r.Peer == "" {
+		return errors.New("peer ID is required")
+	}
+
+	_, err := peer.Decode(r.Peer)
+	if err != nil {
+		return fmt.Errorf("invalid peer ID: %w", err)
+	}
+
+	return nil
+}
+
+// ListReputations implements the REST API endpoint for listing peer reputations.
+func (a *API) ListReputations(ctx echo.Context) error {
+
+	reputations, err := a.Node.PeerReputations(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve peer reputations: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, reputations)
+}
+
+// GetReputation implements the REST API endpoint for retrieving the reputation of a peer.
+func (a *API) GetReputation(ctx echo.Context) error {
+
+	var req ReputationRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	// Validated above.
+	id, _ := peer.Decode(req.Peer)
+
+	reputation, err := a.Node.PeerReputation(ctx.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve peer reputation: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, reputation)
+}
@@ -0,0 +1,101 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ListReputations(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	rec, ctx, err := setupRecorder(reputationEndpoint, nil)
+	require.NoError(t, err)
+
+	err = srv.ListReputations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var reputations []blockless.PeerReputation
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &reputations))
+	require.Equal(t, []blockless.PeerReputation{mocks.GenericPeerReputation}, reputations)
+}
+
+func TestAPI_GetReputation(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PeerReputationFunc = func(_ context.Context, id peer.ID) (blockless.PeerReputation, error) {
+			require.Equal(t, mocks.GenericPeerID, id)
+			return mocks.GenericPeerReputation, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ReputationRequest{
+			Peer: mocks.GenericPeerID.String(),
+		}
+
+		rec, ctx, err := setupRecorder(reputationGetEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.GetReputation(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var reputation blockless.PeerReputation
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &reputation))
+		require.Equal(t, mocks.GenericPeerReputation, reputation)
+	})
+	t.Run("reputation not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PeerReputationFunc = func(context.Context, peer.ID) (blockless.PeerReputation, error) {
+			return blockless.PeerReputation{}, blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ReputationRequest{
+			Peer: mocks.GenericPeerID.String(),
+		}
+
+		rec, ctx, err := setupRecorder(reputationGetEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.GetReputation(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("invalid peer ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ReputationRequest{
+			Peer: "not-a-peer-id",
+		}
+
+		_, ctx, err := setupRecorder(reputationGetEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.GetReputation(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
@@ -1,11 +1,14 @@
 package api
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
 )
 
 func (r FunctionResultRequest) Valid() error {
@@ -33,11 +36,28 @@ func (a *API) ExecutionResult(ctx echo.Context) error {
 	}
 
 	// Lookup execution result.
-	result, ok := a.Node.ExecutionResult(request.Id)
-	if !ok {
-		return ctx.NoContent(http.StatusNotFound)
+	record, err := a.Node.ExecutionResult(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve execution result: %w", err))
+	}
+
+	// Transform the node response format to the one returned by the API.
+	aggregated, message := aggregateResults(record.Aggregation, record.Results, a.aggregationOptions(ctx.Request().Context(), record.Aggregation, record.Results)...)
+
+	res := FunctionResultResponse{
+		Code:         string(record.Code),
+		RequestId:    record.RequestID,
+		Message:      cmp.Or(record.Message, message),
+		Results:      aggregated,
+		Aggregation:  record.Aggregation.Strategy(),
+		Verification: resultVerification(record.Results, record.Cluster),
+		Cluster:      record.Cluster,
 	}
 
 	// Send the response back.
-	return ctx.JSON(http.StatusOK, result)
+	return ctx.JSON(http.StatusOK, res)
 }
@@ -1,15 +1,17 @@
 package api_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 
 	"github.com/blocklessnetwork/b7s/api"
-	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/testing/mocks"
 )
 
@@ -29,17 +31,25 @@ func TestAPI_ExecutionResult(t *testing.T) {
 		err = srv.ExecutionResult(ctx)
 		require.NoError(t, err)
 
-		var res execute.ResultMap
+		var res api.FunctionResultResponse
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
 
 		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
-		require.Equal(t, mocks.GenericExecutionResultMap, res)
+
+		record := mocks.GenericExecutionRecord
+		require.Equal(t, string(record.Code), res.Code)
+		require.Equal(t, record.RequestID, res.RequestId)
+		require.Equal(t, record.Cluster, res.Cluster)
+		require.Len(t, res.Results, 1)
+		require.Equal(t, mocks.GenericExecutionResult.Result, res.Results[0].Result)
+		require.Equal(t, float64(100), res.Results[0].Frequency)
+		require.Equal(t, []peer.ID{mocks.GenericPeerID}, res.Results[0].Peers)
 	})
 	t.Run("response not found", func(t *testing.T) {
 
 		node := mocks.BaselineNode(t)
-		node.ExecutionResultFunc = func(id string) (execute.ResultMap, bool) {
-			return execute.ResultMap{}, false
+		node.ExecutionResultFunc = func(context.Context, string) (blockless.ExecutionRecord, error) {
+			return blockless.ExecutionRecord{}, blockless.ErrNotFound
 		}
 
 		srv := api.New(mocks.NoopLogger, node)
@@ -56,6 +66,30 @@ func TestAPI_ExecutionResult(t *testing.T) {
 
 		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
 	})
+	t.Run("node failure", func(t *testing.T) {
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionResultFunc = func(context.Context, string) (blockless.ExecutionRecord, error) {
+			return blockless.ExecutionRecord{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionResultRequest{
+			Id: "dummy-request-id",
+		}
+
+		_, ctx, err := setupRecorder(resultEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionResult(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
 }
 
 func TestAPI_ExecutionResult_HandlesErrors(t *testing.T) {
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/robfig/cron/v3"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+)
+
+func (r ScheduleCreateRequest) Valid() error {
+
+	_, err := cron.ParseStandard(r.Cron)
+	if err != nil {
+		return fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	err = r.Request.Valid()
+	if err != nil {
+		return fmt.Errorf("invalid execution request: %w", err)
+	}
+
+	return nil
+}
+
+func (r ScheduleRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("schedule ID is required")
+	}
+
+	return nil
+}
+
+// CreateSchedule implements the REST API endpoint for creating a schedule for recurring function execution.
+func (a *API) CreateSchedule(ctx echo.Context) error {
+
+	var req ScheduleCreateRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	schedule, err := a.Node.CreateSchedule(ctx.Request().Context(), req.Cron, req.Request, req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not create schedule: %w", err))
+	}
+
+	return ctx.JSON(http.StatusCreated, schedule)
+}
+
+// ListSchedules implements the REST API endpoint for listing schedules.
+func (a *API) ListSchedules(ctx echo.Context) error {
+
+	schedules, err := a.Node.Schedules(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve schedules: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, schedules)
+}
+
+// GetSchedule implements the REST API endpoint for retrieving a schedule along with its run history.
+func (a *API) GetSchedule(ctx echo.Context) error {
+
+	var req ScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	schedule, err := a.Node.Schedule(ctx.Request().Context(), req.Id)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve schedule: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, schedule)
+}
+
+// RemoveSchedule implements the REST API endpoint for removing a schedule.
+func (a *API) RemoveSchedule(ctx echo.Context) error {
+
+	var req ScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	err = a.Node.RemoveSchedule(ctx.Request().Context(), req.Id)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not remove schedule: %w", err))
+	}
+
+	res := ScheduleRemoveResponse{
+		Id:   req.Id,
+		Code: codes.OK.String(),
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,207 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_CreateSchedule(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			expectedCron    = "*/5 * * * *"
+			expectedRequest = mocks.GenericExecutionRequest
+		)
+
+		node := mocks.BaselineNode(t)
+		node.CreateScheduleFunc = func(_ context.Context, cron string, req execute.Request, _ string) (blockless.ScheduleRecord, error) {
+			require.Equal(t, expectedCron, cron)
+			require.Equal(t, expectedRequest, req)
+
+			return mocks.GenericScheduleRecord, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ScheduleCreateRequest{
+			Cron:    expectedCron,
+			Request: expectedRequest,
+		}
+
+		rec, ctx, err := setupRecorder(schedulesEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Result().StatusCode)
+
+		var schedule blockless.ScheduleRecord
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &schedule))
+		require.Equal(t, mocks.GenericScheduleRecord.ID, schedule.ID)
+	})
+	t.Run("invalid cron expression", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ScheduleCreateRequest{
+			Cron:    "every now and then",
+			Request: mocks.GenericExecutionRequest,
+		}
+
+		_, ctx, err := setupRecorder(schedulesEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("invalid execution request", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ScheduleCreateRequest{
+			Cron: "@hourly",
+		}
+
+		_, ctx, err := setupRecorder(schedulesEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.CreateSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_GetSchedule(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ScheduleRequest{
+			Id: mocks.GenericScheduleRecord.ID,
+		}
+
+		rec, ctx, err := setupRecorder(scheduleGetEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.GetSchedule(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var schedule blockless.ScheduleRecord
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &schedule))
+		require.Equal(t, mocks.GenericScheduleRecord, schedule)
+	})
+	t.Run("schedule not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ScheduleFunc = func(context.Context, string) (blockless.ScheduleRecord, error) {
+			return blockless.ScheduleRecord{}, blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ScheduleRequest{
+			Id: mocks.GenericScheduleRecord.ID,
+		}
+
+		rec, ctx, err := setupRecorder(scheduleGetEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.GetSchedule(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing schedule ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(scheduleGetEndpoint, api.ScheduleRequest{})
+		require.NoError(t, err)
+
+		err = srv.GetSchedule(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_ListSchedules(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	rec, ctx, err := setupRecorder(schedulesEndpoint, nil)
+	require.NoError(t, err)
+
+	err = srv.ListSchedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+	var schedules []blockless.ScheduleRecord
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &schedules))
+	require.Equal(t, []blockless.ScheduleRecord{mocks.GenericScheduleRecord}, schedules)
+}
+
+func TestAPI_RemoveSchedule(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.ScheduleRequest{
+			Id: mocks.GenericScheduleRecord.ID,
+		}
+
+		rec, ctx, err := setupRecorder(scheduleRemoveEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.RemoveSchedule(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+	})
+	t.Run("schedule not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.RemoveScheduleFunc = func(context.Context, string) error {
+			return blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.ScheduleRequest{
+			Id: mocks.GenericScheduleRecord.ID,
+		}
+
+		rec, ctx, err := setupRecorder(scheduleRemoveEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.RemoveSchedule(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+}
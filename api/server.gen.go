@@ -18,18 +18,78 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List installed functions
+	// (GET /api/v1/functions)
+	ListFunctions(ctx echo.Context) error
 	// Execute a Blockless Function
 	// (POST /api/v1/functions/execute)
 	ExecuteFunction(ctx echo.Context) error
+	// Execute a Blockless Function for many sets of inputs
+	// (POST /api/v1/functions/execute/batch)
+	ExecuteFunctionBatch(ctx echo.Context) error
+	// Execute a Blockless Function over a sharded input
+	// (POST /api/v1/functions/execute/mapreduce)
+	ExecuteFunctionMapReduce(ctx echo.Context) error
+	// Execute a Blockless Function and stream its progress
+	// (POST /api/v1/functions/execute/stream)
+	ExecuteFunctionStream(ctx echo.Context) error
 	// Install a Blockless Function
 	// (POST /api/v1/functions/install)
 	InstallFunction(ctx echo.Context) error
+	// Get function install status
+	// (POST /api/v1/functions/install/status)
+	InstallStatus(ctx echo.Context) error
+	// Cancel an Execution Request
+	// (POST /api/v1/functions/requests/cancel)
+	CancelExecution(ctx echo.Context) error
 	// Get the result of an Execution Request
 	// (POST /api/v1/functions/requests/result)
 	ExecutionResult(ctx echo.Context) error
+	// Get the status of an Execution Request
+	// (POST /api/v1/functions/requests/status)
+	ExecutionStatus(ctx echo.Context) error
+	// Uninstall a Blockless Function
+	// (POST /api/v1/functions/uninstall)
+	UninstallFunction(ctx echo.Context) error
+	// Get function uninstall status
+	// (POST /api/v1/functions/uninstall/status)
+	UninstallStatus(ctx echo.Context) error
 	// Check Node health
 	// (GET /api/v1/health)
 	Health(ctx echo.Context) error
+	// Get node information
+	// (GET /api/v1/node)
+	GetNodeInfo(ctx echo.Context) error
+	// List known peers
+	// (GET /api/v1/peers)
+	ListPeers(ctx echo.Context) error
+	// List connected peers
+	// (GET /api/v1/peers/connected)
+	ListConnectedPeers(ctx echo.Context) error
+	// List peer reputations
+	// (GET /api/v1/reputation)
+	ListReputations(ctx echo.Context) error
+	// Get peer reputation
+	// (POST /api/v1/reputation/get)
+	GetReputation(ctx echo.Context) error
+	// List schedules
+	// (GET /api/v1/schedules)
+	ListSchedules(ctx echo.Context) error
+	// Create a schedule
+	// (POST /api/v1/schedules)
+	CreateSchedule(ctx echo.Context) error
+	// Get a schedule
+	// (POST /api/v1/schedules/get)
+	GetSchedule(ctx echo.Context) error
+	// Remove a schedule
+	// (POST /api/v1/schedules/remove)
+	RemoveSchedule(ctx echo.Context) error
+	// Execute a workflow
+	// (POST /api/v1/workflows)
+	ExecuteWorkflow(ctx echo.Context) error
+	// Get workflow status
+	// (POST /api/v1/workflows/status)
+	WorkflowStatus(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -37,6 +97,15 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ListFunctions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFunctions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFunctions(ctx)
+	return err
+}
+
 // ExecuteFunction converts echo context to params.
 func (w *ServerInterfaceWrapper) ExecuteFunction(ctx echo.Context) error {
 	var err error
@@ -46,6 +115,33 @@ func (w *ServerInterfaceWrapper) ExecuteFunction(ctx echo.Context) error {
 	return err
 }
 
+// ExecuteFunctionBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteFunctionBatch(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteFunctionBatch(ctx)
+	return err
+}
+
+// ExecuteFunctionMapReduce converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteFunctionMapReduce(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteFunctionMapReduce(ctx)
+	return err
+}
+
+// ExecuteFunctionStream converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteFunctionStream(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteFunctionStream(ctx)
+	return err
+}
+
 // InstallFunction converts echo context to params.
 func (w *ServerInterfaceWrapper) InstallFunction(ctx echo.Context) error {
 	var err error
@@ -55,6 +151,24 @@ func (w *ServerInterfaceWrapper) InstallFunction(ctx echo.Context) error {
 	return err
 }
 
+// InstallStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) InstallStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InstallStatus(ctx)
+	return err
+}
+
+// CancelExecution converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelExecution(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelExecution(ctx)
+	return err
+}
+
 // ExecutionResult converts echo context to params.
 func (w *ServerInterfaceWrapper) ExecutionResult(ctx echo.Context) error {
 	var err error
@@ -64,6 +178,33 @@ func (w *ServerInterfaceWrapper) ExecutionResult(ctx echo.Context) error {
 	return err
 }
 
+// ExecutionStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecutionStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecutionStatus(ctx)
+	return err
+}
+
+// UninstallFunction converts echo context to params.
+func (w *ServerInterfaceWrapper) UninstallFunction(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UninstallFunction(ctx)
+	return err
+}
+
+// UninstallStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) UninstallStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UninstallStatus(ctx)
+	return err
+}
+
 // Health converts echo context to params.
 func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
 	var err error
@@ -73,6 +214,105 @@ func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
 	return err
 }
 
+// GetNodeInfo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeInfo(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeInfo(ctx)
+	return err
+}
+
+// ListPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPeers(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPeers(ctx)
+	return err
+}
+
+// ListConnectedPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListConnectedPeers(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListConnectedPeers(ctx)
+	return err
+}
+
+// ListReputations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListReputations(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListReputations(ctx)
+	return err
+}
+
+// GetReputation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReputation(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReputation(ctx)
+	return err
+}
+
+// ListSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSchedules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSchedules(ctx)
+	return err
+}
+
+// CreateSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSchedule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSchedule(ctx)
+	return err
+}
+
+// GetSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSchedule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSchedule(ctx)
+	return err
+}
+
+// RemoveSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveSchedule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveSchedule(ctx)
+	return err
+}
+
+// ExecuteWorkflow converts echo context to params.
+func (w *ServerInterfaceWrapper) ExecuteWorkflow(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExecuteWorkflow(ctx)
+	return err
+}
+
+// WorkflowStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowStatus(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowStatus(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -101,58 +341,181 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api/v1/functions", wrapper.ListFunctions)
 	router.POST(baseURL+"/api/v1/functions/execute", wrapper.ExecuteFunction)
+	router.POST(baseURL+"/api/v1/functions/execute/batch", wrapper.ExecuteFunctionBatch)
+	router.POST(baseURL+"/api/v1/functions/execute/mapreduce", wrapper.ExecuteFunctionMapReduce)
+	router.POST(baseURL+"/api/v1/functions/execute/stream", wrapper.ExecuteFunctionStream)
 	router.POST(baseURL+"/api/v1/functions/install", wrapper.InstallFunction)
+	router.POST(baseURL+"/api/v1/functions/install/status", wrapper.InstallStatus)
+	router.POST(baseURL+"/api/v1/functions/requests/cancel", wrapper.CancelExecution)
 	router.POST(baseURL+"/api/v1/functions/requests/result", wrapper.ExecutionResult)
+	router.POST(baseURL+"/api/v1/functions/requests/status", wrapper.ExecutionStatus)
+	router.POST(baseURL+"/api/v1/functions/uninstall", wrapper.UninstallFunction)
+	router.POST(baseURL+"/api/v1/functions/uninstall/status", wrapper.UninstallStatus)
 	router.GET(baseURL+"/api/v1/health", wrapper.Health)
+	router.GET(baseURL+"/api/v1/node", wrapper.GetNodeInfo)
+	router.GET(baseURL+"/api/v1/peers", wrapper.ListPeers)
+	router.GET(baseURL+"/api/v1/peers/connected", wrapper.ListConnectedPeers)
+	router.GET(baseURL+"/api/v1/reputation", wrapper.ListReputations)
+	router.POST(baseURL+"/api/v1/reputation/get", wrapper.GetReputation)
+	router.GET(baseURL+"/api/v1/schedules", wrapper.ListSchedules)
+	router.POST(baseURL+"/api/v1/schedules", wrapper.CreateSchedule)
+	router.POST(baseURL+"/api/v1/schedules/get", wrapper.GetSchedule)
+	router.POST(baseURL+"/api/v1/schedules/remove", wrapper.RemoveSchedule)
+	router.POST(baseURL+"/api/v1/workflows", wrapper.ExecuteWorkflow)
+	router.POST(baseURL+"/api/v1/workflows/status", wrapper.WorkflowStatus)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW2/bOhL+K4R2H2U7cW6o39I03Qbb02abRYvdg8CgpJHEmiJVknLiBv7vC150sSXH",
-	"lzjHPYvzFIeiyE8z31w4wycv5FnOGTAlvdGTJ8MUMmx+XiaJgAQriL6ALKjSYxHIUJBcEc68kWfHEY8R",
-	"Zuj6EcJCP0Bf4EcBUnm+lwueg1AEzIKx0A9YOGuv9L58pBdTKZFI2LVxxlmCMKWI8QgkUilWCMxWECGV",
-	"AhLVbvCIs5yCNzrqn5/7nprl4I08VmQBCM/3HnsJ77nBmHKszk+boz05IXmPG0SY9nJOmALhjZQoYO57",
-	"OYCQbeAfSZAPc3TzTlrkgD7VOBOumh/ThPi7dzx8d/JPzr99yU8uv04ufqhweDk9fyQ/ksuf+Pi/vJjI",
-	"f+H/hHfDcPrpzenkw90Vx56/y2uBd+97REFm8DsJSCUIS7x5JScsBJ5tIRBRkeLvAmJv5P1tUFNp4Hg0",
-	"qFjhODSvN+TBdwjVkmJwSbr+l1JmNSCS5VyYLXOsUm/kJUSlRdAPeTYIKA8nFKRkoB64mAyCCznQnBlU",
-	"S+qP3fTrlsnfqXppuF8w8qMAp+OKBl3mUOngOYm1zO4ZFXUITB5MYkoJEhQKLpUCqXiXtWhREAFI5hCS",
-	"mIQIl3MRlmjKizDVVrbsOACHaafp3Q5v0S2AKO1PT0QZZhFWXMyq1ZuiP5wF7svwOIMxjzeSRy3ehxQE",
-	"oAfrLrUKsEIUsGYwg/8nx7TGv7jQ0e9g64vsJuMRUDlwy29jN5WjuOIsJklbr3a8ENh4E7uORDEXK/3M",
-	"ovXg8lPXuh4dui7r2XPfCzmTwGQhx5gmXBCVZm2A31ISpqiaiqqpSKa8oBEKQMPNIHKoiXQhXL/f4J6X",
-	"B7HGv8iEzUUJbDqe4i7Hc82mRHCWAVNoigXBAYVahm9LjaL3BQsdqo2c9SecQfQV0wJeYNA2QxnzeGxy",
-	"nDb6T2aCNuhGEuRk6xi3+jsq6R5XAPX2yWJOtDb7ERmRUjOvDe+2ftimZafv9VKlcjkaDHBO+m5U25bn",
-	"7zk9GZehzCB9Xpc2fl42XtDLFEyRDNa+a6c5E577nlQRYW1R3SkdnESEblheqOcJWEtrm7d2tR2VCpAp",
-	"p1GHfrmwrsdmNm0OCpA5ZxF6ICpFuEzdFTc+gUSwbPNIFmEIUsYF7SZoO2dfh55kwIuOI8oH/oCoPkI4",
-	"qPoDahwKT8Db2Sw2DDWOFIcOL7dY4AzMo6elCDE1DqwVgreQg8sqIp0/2NXuNxNOjerQ8inDZ0s6YRWX",
-	"Nzro1D4gdlY5Jh1GdXXzrjSouMvmAxzPAiB4eDo9DX/iqcq/T4chP/l+dspP8dlPFRU/wnw2IwzE94SF",
-	"jxdyKIdDeQH4BV4gA5XyDrQ6zpVwv13e/YZiQkFbeCnxJvQUKOW9By5o1H/AMnsBnrykR0fYufp4g7BI",
-	"Ch3U5Yau9PeK7F6vF1LSiylOjr25X4+bv4tD9dRhe+rQm99vmC102OLuAU7xnIRtqdwwIwYZAsOC8DLx",
-	"52ICwkgpQ7IIEsGLXPpoxgsUYu0IRQIK4fpkVk5CwcwNzghLEFESkQiYIjExRlurfWc1L/mPptlUjHzO",
-	"nWxu4TpMSegwcVpI5xnX5cdXbqpJjiPoDPGqqMoA3Xnu8OjoRUYqJU46tr5ZUXpAMSYUIt+GYfc6ykiS",
-	"KpTiKaCMC0CExRzhgBfKIhfCnJx3Renqcp3Or/Z9XceXhhM8j4MgPIPecXR83jsF/KYXnJ1d9M6O41N8",
-	"joOz87PwRRCr0s42FRn5fBVrCzp2VlUvQ1Vginih8kK1ieQjSiaAqpzws5nn1wPXWnE+un4kCl3xCBCo",
-	"sN9vF1UeiRp3U9i8qh91sXhXYUsVgRDPpMQG95537MwJl0S3vy03TAjdYcHufqi8p4yON0wqTOnq7OfP",
-	"k7ysjoj4TxUPfa8QZPG8vK/YqrX5glDaIs3KgOr8yn5C3vzliK2zbbB8kSP/AOWaSc91tPxa1ZHmQaP9",
-	"hG7etTzsLxv5lkixH06UEv4rx/orxzp0jvUBMFWpZUnHYVZnNtI+9H9Vx9WoLLczRDSBWc+cPlGOiWh9",
-	"BcPZ0leYkd21WFWI6hXt0J5ckIO3Venomk2/4oPVjZZ6JW0dVc9sqbThqFiCLAVtSVJ7h66mDWiK6nNw",
-	"LaiWjUtEFGIQancjZvVOZv268YOwANdetT2YYKYDXKM/Wuk1xlRCpYCAcwqYbcEU3Oz+Pmvx7Q5cSTQr",
-	"AUo/x6Zis16wLXHO/adNEW/e4LnfurkoD8nOqzrgLifEOu217cQ6Frn4vNRlaly1MfdYuluMGe5qdriG",
-	"dB2IbgXJNE31+lXBrswLmjFpxxb0zlXGlXd7LP6luz2EWWnUyA99u2Cn18L9XUrYtBNSCewgRtFu8LWS",
-	"VGA4oM1WyPYOcLFkvW3z+OmVSw8tERxKFwsN0y3vPNQ1frdMyyFFEBTJWGfbL9JlJMgUhBwLztXYyuTp",
-	"BbcTlJgttcH31ymJC6ANdNu3+ClPEhstdj8zZVx0XO78zYwjSjKium+A7AxaFGxc9ud/ucbv2493izQ/",
-	"iK1ptPCoQDBM3/GwI869JyxCOg0wR1SbEdw94MSKpBDUXdgYDQbSDvcJ1wBK+1pc7t9au0Sitxd36APg",
-	"yGZndyCmIFCAJUSI2ybV5xzY5e0NOukfVYU2Y/F9rRuijI3oZcwKX/QpW0/vNV/UpwYQ0m591D/tv9HI",
-	"eA4M58QbeSf9o/6J9g9YpebbBzgng+nxoCxc1iLVuuBd1aFrd8cGd3cXteMxsG+ienLjucua3vJo5vrJ",
-	"CpjZBuc5dZ88+C5tQLLRYYt7tTYlM3reCnZ9rKiP6qZ2Y8Skj72vANZVhzrQ3lV3QhquYe57pxbIcgo7",
-	"xZREDYsuU9O57511v2FNAElLRFt60TBkkemsdL3AFE5ks0EpPXMiaBOK2PLoakK5+ulmhHKTX5lQK/oB",
-	"HYpaA/6Po9WqavRqzLhJFYTDCeMPFKIEoiUmrPnGjZngttI/ymZfNyM2K0CvcDZ1M/F1ubFYRJ8vFnH+",
-	"KG0v1ZlX+j2raSNNAUoQmGotr/En+/AiG6tyDYtSU8TUGBLoYMxVCuHERkY3c5kcH8rhV9PJQp21QxMG",
-	"HZEO4GxJUF1fUMrEDdybRa2wpSkG1emIHA0GOSipuICTfp2ZWPmdmGs5brlWQJ+CmKmUsMSlO20rl95W",
-	"adNCoqSRVTlcv0ziIh7KgftHE8wWaRvan/vLW3wFQWJXL7ESQZhFCE8xoTgglCh9RncLOZHN7+f/CwAA",
-	"//9f2L021TUAAA==",
+	"H4sIAAAAAAAC/+x9a3PctrLgX0Fx74etW5zRw7Idu2qr1pGTG509ib2Sk9TdY5eEIXtmYJEADYAjTU7p",
+	"v2/hSfA179HIsStV8YgEwWaju9Ho57+jhOUFo0CliF7/OxLJFHKsf76ZTDhMsIT0EkSZSXUtBZFwUkjC",
+	"aPQ6MtcRGyNM0U/3kJTqBrqELyUIGcVRwVkBXBLQE465ukGTeXumn90tNZmcEoG4mRvnjE4QzjJEWQoC",
+	"ySmWCPSrIEVyCoj7t8E9zosMotfHwxcv4kjOC4heR7TMR8CjOLofTNjAXhxnDMsXZ+HVgbglxYBpiHA2",
+	"KBihEnj0WvISHuKoAOCiDfg/yag4LdDFW2EgB/RbBeeEyfBjQhD/FZ2cvn32fxj787J49uaP25dfZHL6",
+	"ZvbinnyZvPkLn/w/Vt6K/4v/O7k6TWa/vTq7/eXqnOEo3uSxUfQpjoiEXMNvMSAkJ3QSPXg8Yc7xfA2E",
+	"cE8U/8FhHL2O/sdRRUpHlo6OPFVYGnqoXshGnyGRjYXBjuiGlw5nFUAkLxjXryywnEavowmR03I0TFh+",
+	"NMpYcpuBEBTkHeO3R6OX4kjRzJGfMnoIJ1v8dU3i71x6oWm/pORLCXaNPRl0sYNfg0UYa7550RJ1IEwc",
+	"GmOE0Q8atCa+fmF3GjPgMeNQhjmghOUjQiF9/ZEiNEA3cI8TeYMGftAdkVNEUqCSJDhDrJRFKfWjE87K",
+	"AlL7YI4/M07kvHq259ExZzkCysrJ1AiXGGGBUpDAcwUJGs01vHjCAXKgEnH1de41kBJM1UvML7futMyB",
+	"k8S+RPjRbuyykZ8Fo4MxgSwNPz74TAfWDGclaNmL9HBEqL7+j6t3v9k57ZRjLCQIjUxRJgkIMS4zhxst",
+	"qCRjt/rhDLCQSJIckGROztpp7oBMphLSQYjhjNxCgPMYjUpZg9o95cDmUJRSU4nHQyUwOciSUyPY8yiO",
+	"gJa5kpWaGKI4cu9RPzXW9Q/9T4W3KI7sF0dx1AI6+hRI4XDCulysM5lFxLBJ41uxWs5SyMSRnXstVpOS",
+	"k1Ep4Y1Un8m6NiYldQgHJApIyJgkCLuxispnrEymakNr7tGAk2nnLvf+9D16D8DdVqcGohzTFEvG5372",
+	"UModbrPb1R7HKFyz8Ur4qNB7NwVN94jbJcDS8hWj8HfSAZZs5Z5p2tR6aL45ZzQlZi2bS+tvGYmEkSB0",
+	"khm1DmE3ARJTVmYpElgSMZ632IjivGMH/A3n4KSenyqkiIjjfJEoWvJ91Gzarfde0BlwqV/LSpmwCorE",
+	"IyKAYowzAR6KEWOZEbErc00BXEmFfhWgwqPZxYhQ+3+BOaRD9JvdF80VIhi1+5+M0URCjNSxgKYokzBE",
+	"V5DPgKMcy2QKAmE0Ay60boHpBGIEw8kQ3Xwsj4+fwf86GZ4Oj5H+IzkdHg+Pb8I95kukMBjF0UTq/6mf",
+	"WgHN9E+iUECZHOgfQr9XY40IKeq7inl201XUKGnj7g+NqTr2ArwhyWqUdPJiWxA6NpUr0Bqvub0QlhiN",
+	"GdcjCNWrZTCHHG2IaB/iyErcVK2nZsFPa4qo84AfDiKiflSE7M8OFxLy9iq8cTJJmOUgVOmPHuE/OojQ",
+	"zyVN1DNDdGGGqAf07sRmwDlJDT0xCuaW1SATRsdk0lYN6Ox6hrt0jZ/ojHBGtY48w5zgUQaL4Fn1KKTk",
+	"Zarpfos9vMAc5yA7T+/n/7xAmE9KBfkuAPYL9969dAvAhUxJxwZ1JZXSxVOzpouB3oz/V9zXNalqCn0S",
+	"vOKO2a//3aBbS86rLt25Gf4QR2OLyGuSdpDOxVu3iY4rhFfyd4TH8xEQfHo2O0v+wjNZfJ6dJuzZ5+dn",
+	"7Aw//0um5ZekmM8JBf55QpP7l+JUnJ6Kl4C3ENyeSltyW6woKT4EH6Rkure1MZqAflJr/sSs+0pc0SHT",
+	"NueKHOSUpYt1qz/fXP2KxiQLD7G1xZlClrHBHeNZOrzDYhudS7KCJF0ql4ZEJEAxJ8wdChi/Ba4XIEei",
+	"HOkjvYjRnJUowRRJzCeglU53anOD1PHZXJwTOkFECmvQGBPgtW/bhu3D/TOkfo92t+KL9tU1uVYUjAro",
+	"YtsUOqWfLL2RbaTmqgxKNTycHh9vsaw5CIEnXbp055vRGJMM0tiYe+3DKFe2BzTFM0A540oVGjOER6w0",
+	"ujhwrg/Mm8JoLd+d4qmSTgbSLnNkIKxejEej5DkMTtKTF4MzwK8Go+fPXw6en4zP8As8ev7iebIVoD0m",
+	"1Mu6zdSAqiksdsoI4ylwN0Dfcnf4enZVv10tN6tuvDuuQvoBCIscKk7Hq3DSUsgITeG+S/SkcB9izCHM",
+	"TNXlL/GfpOCdAF/jmwoAvsAtEnpFOpw39ruCY8tmFpHtKLMN/7v6MTmUMOtLqX3Ipz14X3aqE+7Ce7O5",
+	"ZnjOKIVEQvq+kzrVVW961gfXknOgMpujxD1pztP1xcZpykEI6JRkOZOA/IjAwKLmI8qIIZm+pDmmZgY8",
+	"IsXZ0cnpS2WSGJ4cyaQ4evX89OVe3HaLN4smbMv48Rf86/2L+Y8//jzCbz6c/znN//ue3u7r4OGJZFhf",
+	"310Qmb+3Dpk1zwxdxsQxmZTc+jsKQwdO/V7uMffWleVHZZbCm2r0QxwlSq2iohTXOJswTuS0w5Tw55Qk",
+	"U+SHIj/UGThHWufPIbVQ+xNBU5wVo7HcQp59pcYFE1xwzcbX2ovVcSzRAxR7BW4ui1sr2Pq/w2P3ZKsN",
+	"mudECEV5XZLQ32yTZacvJ5pKWYjXR0e4IEN7VXFXtDuvS8GJccq1wbV3nLiyuoxTcCp1/EsJpd+9p4BT",
+	"jf6hYzTrTZ6SyRQ4cu/TVmavnowJr2tJJ9uoSWa/vsaVA3EZfZodNPA4KozykkqSw9JnzbDKlCEgM7vQ",
+	"tZAcS5jMu43zljIV1txZdQQomTIB1MbitFy2SuB6+QCIsyxDCc6ywLbucMkxTTWpaH/YIGM4hVRd907h",
+	"uiG9MWxT4bIDS1oF0/7tb3EkpxzElGUde/V7xnuc53btuD5Rp4bCsXfzMy3lSQpNKR7EBHSLnHYA1TLo",
+	"SQ6slN0UlikaCsisgkPi28Btsi6Lraignjvb9kH00g77cMvu4d0/O7HimNlWdINUUB0aP73GXCzmNOm0",
+	"ygiQcW1TuHiLiKhCSkiuQ0YkZHPtkOrUwNQTBWeKHyCtzs3JrTLC0XSXXtJvyiydQl4wqQI8r2+hY+95",
+	"Z59EtzB3hk1t6OxcJbWPF4DVriPKkVdgtMRTDwhlC76FeYxynAK6m2pTcChsPlKi7UgFZxN1YEOMK6HE",
+	"1REQjyXwGKUMUSaRkJgriyyFu+pxG+WkKKvLq844mRD1NY2Aj+hsfJKc4pcweIGPR4Oz0fNng1ejH2Dw",
+	"bHyavsQ/JK/gZDuj5dOyje/I9VadlL10jAaDJCODcYYnJ9FDXF3X/9YvVUNP20NPo4dPh3DufVtugy3c",
+	"Bc0t4QPkRdYZ5dIlzEWpPVfGZ8VLrzapNU3LDDrsed+OVP6biovHZuZtGSBQwCo70KHVrz6v3BoH2Gaw",
+	"rNJ5slJYpXeZMevcDn2ID2ZjX+YDbAucp+sFfCr+v3UyH7QZcwZc7WhrmEz+CJ/Yxl3X9J60A7ESWfp8",
+	"gjYpxiY63hsM3ulxcXXhJ7X0Mfrpnkh0rlwRIJPhsB14dU/kdTcT6EfVrS4+2MJcApwvsJdouHf8xk6D",
+	"QQN1u3vlitYCa0czbz+4VFYSr1sQTqD/OEtoYIjTdlFtcWNZNlBWOoM1K5gHSsM0VyyO9e/UnGOMdFOj",
+	"MU1A/awZ68InVkmmMJ9zIKQ6feFcf0pgbmioHfp2Z3pjXGnSQXKLMz20nfVPVTw31BeylbbeRGulRiz0",
+	"rxuCynxa0ErppKuoBeHEO/W+P/E992H7JbygQuIs6w+r/HoOOv2HbfxVHbXjqOSk7o3bFdsnu+F7TzS9",
+	"UX0rUk2MSqGDMFAyhcQkJxIzuTLJyVI8AbpyEmhXYmWxOHGf/5mN6qIMj87gFT4Z/JCevRyc4efJ4NX4",
+	"GAbPkpPRy/QFPB+fHT8BQWIE89cvTvbDOP9goy4BpTFnt0WOOORshjMTH+gQgopylBExNdwSem6Jkm9a",
+	"9g3RO5rN/Q3ruNV+Qki12zsjQmrd7qsV8gnXHoFr3KHGfdAJzYGCdodFhbcojpS4Vw9GKZYw0C72zSGx",
+	"evKCWJDaQpjhavEch7ulhiaONw4/WCxZHlWixNEtoWlXKBKWNfK9U/sxFrcGNykLjjEWUVEcldT9/rSF",
+	"oQdTMlb6XMmzNmC/X/6zSe7IPWHS3ALJLLbAS0+JEasriyrUYjQPEbWqyVPFyv2DjYwc3iYvqUwSgHQN",
+	"Crf4gbSi7HRnpN2j3H1Ql/t53oR37jlMMRTuBwtSdEAYI1bvSfe/QFpsLarp8/3QuwKGv9vOv9vOv3Xb",
+	"ueOJltbfLXWEJ8jvUmdDDPeZ2q4WoraFvK+AJYSzg6/k4DVm5l3Q8u9O1/xuE3uy4Sc7Oo4HS70PS5Y/",
+	"tXyrtqwKAV+bNctTxnd71iI0/allRscpX19vpA5M8UyJh8Cm5U+Mrf2pO9+0wqsRVofLYYsj91n9ligL",
+	"as6EQkKiSwvqh3ZriVr7nPqnw92Bjqq/AM7k1DBWh3GBpVDJy25f4G6k1xZi4sIRrsNpR61bewdhnQvh",
+	"o5Yb1tk6bxC5gCf2KGvO8NnorxfzIr0tefHXPT0dnX3eZpuxH9knGHq+XtaKkgR4WMnw1SDvPZW7q9ip",
+	"TQMH46hfcaHTlLoUvaKUSBSZpizJkJhinooh+olIlRQnXOiNrhmjDHdVeGaQHCqgfYRYOY4zDt+fMCrJ",
+	"pGSlMLXbxNMtuhS38YYyQkGpu+rffYcl+VU9UPDMr7i4hLRM4AkVX3JpO05cmGV5Crkvjv8WIcCv6K7C",
+	"su8YTwcJK6l8vKjsAgt70tHh9noFYjSCMeNQrYkOyD8Qd3NNtctNYWqUF95KKhhiWuDvMCOsn14JTCIC",
+	"ITFEF2OTTeSS1BqDcMKZELrOvVMDTMpukFjbaaY9273n5O9busrKTEsFW5xyugTg12b1z3ExMJg4iAOg",
+	"6/VP2AcQgPvVugP6pFijCpcZpgtw6Z+mBNdXVGYrqOzRUTv1FuYDU3W4wIT3Vm6uVlRf2UE532pGc2lH",
+	"ws6Ct1ai90909gc+WJZ3o1ZNe438PdudoNr86MRUErMHECUUuormgDDVJK4rRLVYW6gzNQWlM2I+r95U",
+	"L/RtSk+bcvlmKx6p9PGw3v3OMsJxWM1/IXe3K6qbhHJTu1gsqGou+j+0VdJ8tXYl7dLJm+tnVd1pnGXv",
+	"xjrjdzlttChCZfmu+MrVawR9WrvevTgkg51XikNTvTMmRm2B8ruo1TMahYqCWn26lEK3yzDHhPb2SKi2",
+	"0Pec5IrT1PxeqbXvPWQNwP7OTgb+RmcnXRSCiADyQze82OixZJd9MkpqQg0gXR2Pd1Mmqq5JOtgv5azQ",
+	"HW4gwaXQZ0bCkSATimWpewQpFlfnqBEg/8ZD9bU49wRwMCZXvLwqhyu2W6ei4Rt3q1YpUccJU7UPM/pU",
+	"ixjaLz2cA4hlHZrnJcugF0JVomxbZ77oCYKsL6AoR2rEqNUx4l+VBVtT5gQocJztZf1sk46OJhfmRogn",
+	"XwlYWx7Y2HbzSCZEDhKW50QOplhM9UV43bonSa60wbwwA272703zjHkwq/+CqqcCgCJmEGpf1ykUSLdk",
+	"eatLJ91SdkfbtU5t8dD6ZEqM6B87J6KLt1tVydr0dPmUC6jGUV5mktiV6ZXpHlIdE21JogZ1nzDfO/Mc",
+	"tqBrPUS+Lx9HOcOqABZX+ymMzsdVGEKdG4yxqsMCg4Vlys9shHyi9TYq7dMOkhDdueyNvGCFDEYbINsc",
+	"kCoNwWfcbJECUhbp8uwhA0O10kHprwNFbtQJ9qB8c1nV8GxjkOPkFnFIGE9N9pqiUN1vk40E8FkV1+uL",
+	"tba3pQmHxUkn7jBhOrfp4VV4hWv1WNWONYPdSVQXjauOuBt7VfAMOJ7AdYZld6/jN2aA6bI5Anmn5K8A",
+	"mjrXpVoS2/dA7bYcEiA+DMKArZUiiikToMw+YhuAUyLWRG1KxmPgkJoWqiFyt4FjtbQ5g5h66hzjqOBM",
+	"GedThN1F33N5Y4BywKLkkF5z69wRSzBkBmmKutOFtRVqLB3ordbNGO284cLT0UBWTg+rrWSwfq0etdtg",
+	"SzFZet1ZXGYVaCizMOhDSE2krw/LSntM0B9XUUyGhUT2wQPuMYFwP9gm03DL98fVVS2rZrbPg/VjmTpN",
+	"j1/zzzoO/26l/0SZP37JvzYuv+p6fzWSPpAlsWLu3jCup73pNFZEA7NFdEe78v4avey1SqRP4UXmyrOH",
+	"/e2H6Heqlg4BVZ0s0jhoTN/R7b2jC6d6LLB8rO9npGoTychfa+QF/lZ7ZAnjehbzlqEgBgYpAF2Tf90Y",
+	"RffFJ5TkZY6Kqrq9Q8b/PB6cfKpVuNfaqhcIXq+fMQkxqgrTSSZxZvvP+9G+HX3tsXp8Vm7i1TBFU5yN",
+	"m+eFEAj7Gb5dv+q9/x5L1RiuUIYW9Sm29YKLYlZDTUaQAkk9YLrWoxFWF6umx4/ax8Q8tmZ00qpF9Frs",
+	"dDA51yblFvG+VYSbEwrC6vAhU7oWCpAiJW9mOAMqh+hNUWQEBIIZUETGNXInQhOW5fa27oEpo4r5rz+L",
+	"7nbkih6gCsc2Sow6OSvaiRGZUKaEJTKqoSY0V97e984z64NyUBpvcFhcX3ZoWm2DuZzwC8x9/UY1uvVF",
+	"msODJtK17eQ/himWeLhd4EwcaWTB9YKCmhd6BAJfV/NuCtRxJZ04atgGhRaIviqbFgKPHjDFNg2Sdg+N",
+	"5CS/vpsSCaLACXTZbkiOMsCppyyOiSobiaqnKkMAc6UyNwRoLZlSZ+WDSpU/Gqn2C82alUc7zNBvbTRA",
+	"pbOKaZe52zWd51vtOMoCbQxEkNad6W6UfUjxH8GZekYYc7UZa60lNUDUKocOebfN6aG9b11lpsB+G/jv",
+	"LWw1Q24QLVcN3JTv6/2Q1mzTVhUZt9O0hHgKo3Jy7VxmG7NiyolyjF5zxuS1odt/b9FQTfJ5o3PX7kq1",
+	"j0vIAujWt4VkbDIxR4rNj7A54x3m1V/1dZSRnMjupnUbA81Leu3abz25zkY//vOqTuYHkodXrrVCO/7c",
+	"3tGrwiEpud7EKjxpz0BnTf+G0rRKqTfX4kGb0ewTu670lvBOnY0zGipCqVUpzZ4JtJHOWPVYr6k8/3n0",
+	"HP2n+W8LCKdEyG42CZOPSyqanTFixLJU1+WxvdtWOoy4Jb4s6d7Ce4LeHY9Wso7CvbzmJV2c0K1GKWTu",
+	"msx4ZZZZsdtvvVVKf67PlcvRMek7lRvOM6XYv4HZE432Dx7OwOzgONfCoj+lcbdMb6wfpYQYTVmpGk9h",
+	"7aPMGZXT2Pyj9Sh7/Q7gdviRvueQwlhZlDxDCNNv4OZ/q3my+Y1+akzudSczCXyGMz8EZsDn6NmxuNEH",
+	"W1EWzqfN2BApojYnXkLR7x/O9yCY9kfTX3/+mqawCkVbmDIr1lJFFxcUs1khYayjcfxea8S4QpGPL+8f",
+	"doHwHunx1Pa2ndYkC7f/jtQrlZydgevDhRd14TpM1UIlgDJY7qMtKfJDd73ZL0uc/BqyJXtbsfnzGC/p",
+	"EJ1jqiL5XX2sCchOp8oj15bjK62/HXggn3zIaAfTl1Q9lXHG7jqM6ISb3HWczJOMJGjCcTFV1NE+3dVV",
+	"zbocEBKKevDwIl3BwXMlodiVd9dAsKI71yPkQEdv9/7ezefvozGZSkKO+lahiqi5tH6CLTa8CuN9mtVi",
+	"QXlXUUyFlJfJCTxPT/HgbPRsPDiDH/DgVfoiGZyOT/Dx6BX8kL48SGeYir+6w6PVdTA7u/uuZo2rqt/u",
+	"WJOFYa/29l/fhne6v668ILvZQnYK+0aVT+NKjDb89MFimJokKZmRVLW000/4zBvvRwwxhPTxkzSDcLoV",
+	"uVbOxwZLrIzaoeOuYU71S9t6qlejCsLu1QcH4aQ6L+KWWJdFa8adaEHmpetoQS041ia1kIacI6YwoceR",
+	"tm9T86sjuD6OHEI+dc7a3cGvKkTRcvi6I4+Eog1nS0ztoNXyo+40q+lx1f5xWNNXXbRveHg9yGa2u8Nr",
+	"TX3sP72OW3qr3t50s50AAzsKMU1Bsae47jI52pRqLatrAVJOuFa1t4hAjJr8TyMyhuhKP8ZhDBxoYiy/",
+	"ukKS0HbDqpqEsQPmRUYSIpEBCGhC6jW8dpXV9xX20V5i0pFQxKik5EsJjlJ6uWUMMpluW2yuu4eMa1EL",
+	"mGdEF3hU6693HSzcyvvzuZXKPds6Fv0hkVa6k7AeXM32PVDvq5eX1GF7uNCU5R+oxf+GiV+6RGMQgtiz",
+	"IUGxbFlsDYLmxkQMWva6KX3vvb6jkn7Kt7Kg3o1LH4SwUTKmNS7w0Z+5q86nrmph6VXABR2Z23qoGool",
+	"433hfERpnW5QrBRpbJKrmAorxJlJpHL0Dl+iOKKwLZVXGCDClKJfjcTbe2y8Vcx7bZs9iKHkQZ8kJHCK",
+	"s7esq27Cz4RqaWBMqMZ6enWHJyZsQ3cJi6ZSFq+PjoS5PCQs0vK3K23+g5KoRKAfX16hX1R+o65YdKXy",
+	"HrmN+7Vk+q4A+ub9BXo2PPZmFR2VNFRLRaRmezWNnuFSnSnU8EH4YBTUV4iOh2fDV5YmKS5I9Dp6Njwe",
+	"PtPyU071tx/hghzNTo7cquqLE5BdtVxUQIAb5i1ErWLVrgByvFqNazkFJbsMR6iOkal9188eIkWEQRKe",
+	"MvMbtUoC1YDiQiko+vEjF0prBM7K9sN23eamWNKk04ESZc1wD1f40bwjylxVPXIju4bFkcQTEeaSiEhX",
+	"m2otjKd1xSSsq6GOka3QF0dTR7EdHNy3B9sfWTpfC7/ruHC7sLgE7EoAVWUQNyeGFYE1b+iC9qrKUKy8",
+	"UA9xdHp8+riAtC0MOEmgcFVjsZjTZMoZZaUI6iMrUM8Mzpp24BnOSFozPdglU0+86ngihbxgJsH1FuYI",
+	"ZxxwOq8SHLBLFqaybQ/R056+6qNiNVI3rFeyc1xmmRr/vBtwI82RMDLVeLn06Gfdfhwj3u8wkUZgNUwu",
+	"+rUx4iD5HOGxtHmEKWR47kZfqpuDN/qmyloH3mD4JUS9LtMfjbA6HGzE+ojRxMTd6dLMShw6FUHPOkSV",
+	"d5ZlGUpUhC8RKGXUPKaF95RldvxH6nIMtGQ1MSSFWntXyljdS5TiQZ3QH6LLWioF55BhWen7ZiYTf0S4",
+	"skbC/fAj1QVFa1kYTPWy5SBLTnVoi4ua1myCBurPuR6odLpCbYVCqr1IMHMrwdTWEFPrS0AFGShI+Eda",
+	"iirP3vUXGyIDQuWnCmYwDeYhHS4TrnqOPUlYPfe2YlYvc47pHAkwJ0V7mHxM8dv8kn7RF64JpEtF2qi+",
+	"hIh/8xJowXqvLZlyXFSl1bul05Wuc16VPh80O0xkOudKbxn+MKrLzaFBrdGCEjw6vEFLMluSxW5ute1G",
+	"jx9+pO+U6NO9tuwEHNx4Ez3RSiquhed53bWWRB4rA1mCJVAtwuq1knVhE+PeCj9x+JFeNiteE4GkKo5i",
+	"rDAYcZiUGeYN46J+/8VbV/qscj/ov8FWpr7RIs6+V5bCVg/R5QsS42TIGFOvKotY3yT+xspyzJc+35Ms",
+	"6+8tsa4809n/2DcdqKq/P5YwW1AlvuNbfm0WRF9BqnUWUf8u2vqWfm25JiQHnG+ocikGMxNonvSubyws",
+	"Jgc6w0sljUox/Eh/0j+0WjYvAN1oR90NSjBXaELd/T5jdKMUtoFS2G5qHT4vWZad4yzT08Yf6Y2RBI1B",
+	"+poZovL5Mttynqj1vlHa340BUINBQDSf1kAsFRpXBo9/m/OlhHt5pPEyqEikgrZpU2sfIA1ZKGu85wpD",
+	"B9/M2Wx1Zu7ho3X42Vpd+hnZVfZbyWxiB+/ZbOKmv6j3nO2gpiXAP96G1wK5f7ezQ3BIyQgnqrJoBukE",
+	"0gaFLPnGdSnhSPgYpoItakwd9mgMWpZa/mnD0rJ4NspEilqdyMAk2kdlvrTfI9BY3fv/8PBwCOL5Bxst",
+	"InLVL3aZjKxJxrOuTpKttay5lOukp0ih2Xyw6kO5MuVZoMSRUbX7Se9c3+/s1u2LJAhJlIGGhtKwTj5m",
+	"lp+CIPl9EpB524EpxwGxjr3UnXu2pqn21JRJNGYl1QFllOn8YODIBVlts18uIJGNSNJGmC2Vhmbcgl7y",
+	"XVqgVzP3TIPmJQemQQfEKjRosemNkDukQTu1J8GtqG3l1V+f8FbdhoVP/VmP8B5l93wS22b9WLiY8Cw2",
+	"90F4LeG3E8pbuvwrU56v291Pc+4TnK9aR/bpIFIkmc1K7NT9hsg1MQ4U2SqVEWfO0+FrHFdPWJ+GnttD",
+	"i7Cs+SKMx4ZwNGJM6gna+77vT/9IxxP/vgPTfwBHPwv4QT1HjlXZoEak1aTbHk48cW50PClpU6ld6YDS",
+	"qmS/+hHFf/mjiNnG257mMeX3cBF2cVBpr+qqR5XqyVUPK1Pdf783+udcBY2ZyCM7skkQv7jLe0O/eYOl",
+	"tw78/2YD3AyA86be3PEFDif2Qg0h1Ib4daLD8aAahC7exshGXcW2SVLQIEU7y5f34qkj879ABq1k9oZR",
+	"/45ebFbtpDrIjTaHVBhVt+r49K3W+qPL9JD6LommVZeSRuOadsjYezCSav/hYupN60SIma45BgXbKEV6",
+	"vnCyCuPm7zbKjxJGqc7IXR/5RKCk5ByozObIz9NFsGqKczfg8dah9sp1FqT6GLsobTQ3hyxENa91wOjH",
+	"cjXOqLRZ1ol4LASZqAgXRet9+K5qNz8e0VfvXAfb6hPDT9+eB1ozrrg6R3Zpltk63PiwY0ktWtcrTEEC",
+	"VLVskiEiu6R6rZj+PjSmdkHvR1aSmlTSpor39bXbWlNqzLfD82eDyhYTmS/FtFgCaKXMDW2Uwxt31kVo",
+	"c/6Vf9Vj8L172zocXyFja1YXwcc69FfXPj3EfbZ0XcorKDWzQe3Bhl1dz3hVVa7ZBwN3VyJbiYlPdg5E",
+	"pwPbodMVVlzGvyKgn83N3s3V7KGGLoZcLvQDImkckW0RRVe0IG+UTuyS8Y9EIAeS76uQxtYi3dPY7mT5",
+	"ZpRjzHKLDIbWJOgeGapKwcIHXARuOh+WjMdjo483acdM9m2QT6Mq3SI5Y9YgfWpE1Vr65XTl8n7FKmFl",
+	"brBKmkXpZlWVYlvPh1U5wCb3NwkKT9RTgHU0vBk1/Eg/hFU/wtDYML8km9uI0iDSzb7ABbiGwfU2eP/i",
+	"7fAjdRmBKt1Ar4so+YxotHLQ+epOwa4ORwMPkTDFLXlZ+Oab7inFbDnmNrjW17HoDFULajftg+OapZlW",
+	"4rjTPby+n9fcmN3kEN35Yhg7iQsL6/JY/qoYqZu/1nIubli3qE5KjQJJ+6Wkg1rBG1+6iJrsKmwruP18",
+	"u9MG7hog9pDWg7/ektOqiK6c6sYYOke4LYdFtFaucS27WLw+qhKfhy7zOWWJOLJ/KBRQnOvKEf6FD3FH",
+	"V3UynltbqjZza1mNZ5hkeERsvrmdyAzomOWyfWoS3ZuPqGYLz4BtfwLW9YvXmK5amIe4ywTQtKGp8UKz",
+	"cTWHs8C2ycYbsYOCmbYVrX1W//Xw6eH/DwDD0BCXN+8AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+func (r FunctionStatusRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("request ID is required")
+	}
+
+	return nil
+}
+
+// ExecutionStatus implements the REST API endpoint for retrieving the status of an execution request.
+func (a *API) ExecutionStatus(ctx echo.Context) error {
+
+	// Get the request ID.
+	var request FunctionStatusRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = request.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, errors.New("missing request ID"))
+	}
+
+	// Lookup execution status.
+	state, err := a.Node.ExecutionStatus(ctx.Request().Context(), request.Id)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve execution status: %w", err))
+	}
+
+	res := FunctionStatusResponse{
+		RequestId: request.Id,
+		State:     state,
+	}
+
+	// Send the response back.
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,86 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecutionStatus(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (execute.State, error) {
+			return execute.StateExecuting, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionStatusRequest{
+			Id: mocks.GenericString,
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		var res api.FunctionStatusResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+		require.Equal(t, mocks.GenericString, res.RequestId)
+		require.Equal(t, execute.StateExecuting, res.State)
+	})
+	t.Run("request not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.ExecutionStatusFunc = func(context.Context, string) (execute.State, error) {
+			return "", blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionStatusRequest{
+			Id: "dummy-request-id",
+		}
+
+		rec, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing request ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.FunctionStatusRequest{}
+
+		_, ctx, err := setupRecorder(statusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecutionStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
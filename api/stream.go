@@ -0,0 +1,121 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/node/aggregate"
+)
+
+// ExecuteFunctionStream implements the REST API endpoint for function execution, streaming execution progress as server-sent events.
+func (a *API) ExecuteFunctionStream(ctx echo.Context) error {
+
+	// Unpack the API request.
+	var req ExecutionRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	exr := req.executionRequest()
+	err = exr.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	id, events, err := a.Node.ExecuteFunctionStream(ctx.Request().Context(), exr, req.Topic)
+	if errors.Is(err, blockless.ErrIdempotencyKeyConflict) {
+		return echo.NewHTTPError(http.StatusConflict, err)
+	}
+	if errors.Is(err, blockless.ErrExecutionQueueFull) {
+		return echo.NewHTTPError(http.StatusTooManyRequests, err)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not start execution: %w", err))
+	}
+
+	log := a.Log.With().Str("request", id).Logger()
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	for event := range events {
+
+		opts := a.aggregationOptions(ctx.Request().Context(), exr.Config.ResultAggregation, event.Results)
+		err = writeEvent(res, event, exr.Config.ResultAggregation, opts...)
+		if err != nil {
+			// Client is most likely gone - nothing more we can do.
+			log.Warn().Err(err).Stringer("event", event.Type).Msg("could not write execution event")
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func writeEvent(res *echo.Response, event execute.Event, aggregation execute.ResultAggregation, opts ...aggregate.Option) error {
+
+	payload, err := json.Marshal(eventPayload(event, aggregation, opts...))
+	if err != nil {
+		return fmt.Errorf("could not encode event: %w", err)
+	}
+
+	_, err = fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event.Type, payload)
+	if err != nil {
+		return fmt.Errorf("could not write event: %w", err)
+	}
+
+	res.Flush()
+
+	return nil
+}
+
+// eventPayload transforms the node event to the format returned by the API.
+func eventPayload(event execute.Event, aggregation execute.ResultAggregation, opts ...aggregate.Option) any {
+
+	switch event.Type {
+	case execute.EventState:
+		return FunctionStatusResponse{
+			RequestId: event.RequestID,
+			State:     event.State,
+		}
+
+	case execute.EventRollCall:
+		return ExecutionRollCallEvent{
+			RequestId: event.RequestID,
+			Peer:      event.Peer.String(),
+		}
+
+	case execute.EventResult:
+		return ExecutionResultEvent{
+			RequestId: event.RequestID,
+			Peer:      event.Peer.String(),
+			Code:      string(event.Result.Code),
+			Result:    event.Result.Result.Result,
+		}
+
+	default:
+		aggregated, message := aggregateResults(aggregation, event.Results, opts...)
+
+		return ExecutionResponse{
+			Code:         string(event.Code),
+			RequestId:    event.RequestID,
+			Message:      cmp.Or(event.Message, message),
+			Results:      aggregated,
+			Aggregation:  aggregation.Strategy(),
+			Verification: resultVerification(event.Results, event.Cluster),
+			Cluster:      event.Cluster,
+		}
+	}
+}
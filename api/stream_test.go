@@ -0,0 +1,135 @@
+package api_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecuteStream(t *testing.T) {
+
+	requestID := mocks.GenericUUID.String()
+
+	node := mocks.BaselineNode(t)
+	node.ExecuteFunctionStreamFunc = func(context.Context, execute.Request, string) (string, <-chan execute.Event, error) {
+
+		events := make(chan execute.Event, 4)
+		events <- execute.Event{
+			Type:      execute.EventState,
+			RequestID: requestID,
+			State:     execute.StateRollCalling,
+		}
+		events <- execute.Event{
+			Type:      execute.EventRollCall,
+			RequestID: requestID,
+			Peer:      mocks.GenericPeerID,
+		}
+		events <- execute.Event{
+			Type:      execute.EventResult,
+			RequestID: requestID,
+			Peer:      mocks.GenericPeerID,
+			Result:    execute.NodeResult{Result: mocks.GenericExecutionResult},
+		}
+		events <- execute.Event{
+			Type:      execute.EventDone,
+			RequestID: requestID,
+			Code:      codes.OK,
+			Results:   mocks.GenericExecutionResultMap,
+		}
+		close(events)
+
+		return requestID, events, nil
+	}
+
+	srv := api.New(mocks.NoopLogger, node)
+
+	rec, ctx, err := setupRecorder(streamEndpoint, mocks.GenericExecutionRequest)
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunctionStream(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+	require.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+
+	type sse struct {
+		event string
+		data  string
+	}
+
+	// Parse the received events.
+	var received []sse
+	var current sse
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			current.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			current.data = strings.TrimPrefix(line, "data: ")
+		case line == "":
+			received = append(received, current)
+			current = sse{}
+		}
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, received, 4)
+
+	require.Equal(t, execute.EventState.String(), received[0].event)
+	var state api.FunctionStatusResponse
+	require.NoError(t, json.Unmarshal([]byte(received[0].data), &state))
+	require.Equal(t, execute.StateRollCalling, state.State)
+
+	require.Equal(t, execute.EventRollCall.String(), received[1].event)
+	var rollCall api.ExecutionRollCallEvent
+	require.NoError(t, json.Unmarshal([]byte(received[1].data), &rollCall))
+	require.Equal(t, mocks.GenericPeerID.String(), rollCall.Peer)
+
+	require.Equal(t, execute.EventResult.String(), received[2].event)
+	var result api.ExecutionResultEvent
+	require.NoError(t, json.Unmarshal([]byte(received[2].data), &result))
+	require.Equal(t, mocks.GenericPeerID.String(), result.Peer)
+	require.Equal(t, mocks.GenericExecutionResult.Result, result.Result)
+
+	require.Equal(t, execute.EventDone.String(), received[3].event)
+	var done api.ExecutionResponse
+	require.NoError(t, json.Unmarshal([]byte(received[3].data), &done))
+	require.Equal(t, codes.OK.String(), done.Code)
+	require.Equal(t, requestID, done.RequestId)
+	require.Len(t, done.Results, 1)
+	require.Equal(t, mocks.GenericExecutionResult.Result, done.Results[0].Result)
+}
+
+func TestAPI_ExecuteStream_HandlesErrors(t *testing.T) {
+
+	srv := setupAPI(t)
+
+	const malformedJSON = `
+	{
+		"function_id" : "generic-function-id",
+		"method" : 14
+	}`
+
+	_, ctx, err := setupRecorder(streamEndpoint, []byte(malformedJSON))
+	require.NoError(t, err)
+
+	err = srv.ExecuteFunctionStream(ctx)
+	require.Error(t, err)
+
+	echoErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+
+	require.Equal(t, http.StatusBadRequest, echoErr.Code)
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+func (r FunctionUninstallRequest) Valid() error {
+
+	if r.Cid == "" {
+		return errors.New("function CID is required")
+	}
+
+	return nil
+}
+
+// UninstallFunction implements the REST API endpoint for removing a function from the workers.
+func (a *API) UninstallFunction(ctx echo.Context) error {
+
+	var req FunctionUninstallRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+	}
+
+	job, err := a.Node.PublishFunctionUninstall(ctx.Request().Context(), req.Cid, req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("function uninstall failed: %w", err))
+	}
+
+	res := FunctionUninstallResponse{
+		Code: strconv.Itoa(http.StatusOK),
+		Id:   job.ID,
+		Cid:  job.CID,
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (r FunctionUninstallStatusRequest) Valid() error {
+
+	if r.Cid == "" {
+		return errors.New("function CID is required")
+	}
+
+	return nil
+}
+
+// UninstallStatus implements the REST API endpoint for retrieving the uninstall progress of a function.
+func (a *API) UninstallStatus(ctx echo.Context) error {
+
+	var req FunctionUninstallStatusRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	job, err := a.Node.UninstallStatus(ctx.Request().Context(), req.Cid)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve uninstall status: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, job)
+}
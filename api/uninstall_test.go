@@ -0,0 +1,157 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_UninstallFunction(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		const (
+			cid   = "dummy-cid"
+			topic = "dummy-topic"
+		)
+
+		node := mocks.BaselineNode(t)
+		node.PublishFunctionUninstallFunc = func(_ context.Context, c string, subgroup string) (blockless.FunctionJob, error) {
+			require.Equal(t, cid, c)
+			require.Equal(t, topic, subgroup)
+
+			job := mocks.GenericUninstallJob
+			job.CID = c
+			job.Topic = subgroup
+			return job, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionUninstallRequest{
+			Cid:   cid,
+			Topic: topic,
+		}
+
+		rec, ctx, err := setupRecorder(uninstallEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.FunctionUninstallResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "200", res.Code)
+		require.Equal(t, mocks.GenericUninstallJob.ID, res.Id)
+		require.Equal(t, cid, res.Cid)
+	})
+	t.Run("missing CID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(uninstallEndpoint, api.FunctionUninstallRequest{})
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("node fails to publish uninstall", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.PublishFunctionUninstallFunc = func(context.Context, string, string) (blockless.FunctionJob, error) {
+			return blockless.FunctionJob{}, mocks.GenericError
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		_, ctx, err := setupRecorder(uninstallEndpoint, api.FunctionUninstallRequest{Cid: "dummy-cid"})
+		require.NoError(t, err)
+
+		err = srv.UninstallFunction(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, echoErr.Code)
+	})
+}
+
+func TestAPI_UninstallStatus(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.UninstallStatusFunc = func(_ context.Context, cid string) (blockless.FunctionJob, error) {
+			require.Equal(t, mocks.GenericUninstallJob.CID, cid)
+			return mocks.GenericUninstallJob, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionUninstallStatusRequest{
+			Cid: mocks.GenericUninstallJob.CID,
+		}
+
+		rec, ctx, err := setupRecorder(uninstallStatusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.UninstallStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var job blockless.FunctionJob
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &job))
+		require.Equal(t, mocks.GenericUninstallJob, job)
+	})
+	t.Run("no uninstall job", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.UninstallStatusFunc = func(context.Context, string) (blockless.FunctionJob, error) {
+			return blockless.FunctionJob{}, blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.FunctionUninstallStatusRequest{
+			Cid: mocks.GenericUninstallJob.CID,
+		}
+
+		rec, ctx, err := setupRecorder(uninstallStatusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.UninstallStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing CID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(uninstallStatusEndpoint, api.FunctionUninstallStatusRequest{})
+		require.NoError(t, err)
+
+		err = srv.UninstallStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
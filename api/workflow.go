@@ -0,0 +1,72 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+func (r WorkflowStatusRequest) Valid() error {
+
+	if r.Id == "" {
+		return errors.New("workflow ID is required")
+	}
+
+	return nil
+}
+
+// ExecuteWorkflow implements the REST API endpoint for workflow execution.
+func (a *API) ExecuteWorkflow(ctx echo.Context) error {
+
+	var req WorkflowRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Workflow.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid workflow: %w", err))
+	}
+
+	id, err := a.Node.ExecuteWorkflow(ctx.Request().Context(), req.Workflow, req.Topic)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not execute workflow: %w", err))
+	}
+
+	res := WorkflowResponse{
+		Id: id,
+	}
+
+	return ctx.JSON(http.StatusAccepted, res)
+}
+
+// WorkflowStatus implements the REST API endpoint for retrieving the workflow progress.
+func (a *API) WorkflowStatus(ctx echo.Context) error {
+
+	var req WorkflowStatusRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("could not unpack request: %w", err))
+	}
+
+	err = req.Valid()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	workflow, err := a.Node.Workflow(ctx.Request().Context(), req.Id)
+	if err != nil {
+		if errors.Is(err, blockless.ErrNotFound) {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("could not retrieve workflow: %w", err))
+	}
+
+	return ctx.JSON(http.StatusOK, workflow)
+}
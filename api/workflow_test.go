@@ -0,0 +1,151 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAPI_ExecuteWorkflow(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			expectedWorkflow = mocks.GenericWorkflowRecord.Workflow
+			expectedTopic    = "dummy-topic"
+		)
+
+		node := mocks.BaselineNode(t)
+		node.ExecuteWorkflowFunc = func(_ context.Context, workflow execute.Workflow, subgroup string) (string, error) {
+			require.Equal(t, expectedWorkflow, workflow)
+			require.Equal(t, expectedTopic, subgroup)
+
+			return mocks.GenericWorkflowRecord.ID, nil
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.WorkflowRequest{
+			Workflow: expectedWorkflow,
+			Topic:    expectedTopic,
+		}
+
+		rec, ctx, err := setupRecorder(workflowsEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteWorkflow(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+
+		var res api.WorkflowResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, mocks.GenericWorkflowRecord.ID, res.Id)
+	})
+	t.Run("workflow with a cycle", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.WorkflowRequest{
+			Workflow: execute.Workflow{
+				Steps: []execute.WorkflowStep{
+					{ID: "first", FunctionID: "function-id", Method: "method", DependsOn: []string{"second"}},
+					{ID: "second", FunctionID: "function-id", Method: "method", DependsOn: []string{"first"}},
+				},
+			},
+		}
+
+		_, ctx, err := setupRecorder(workflowsEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.ExecuteWorkflow(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+	t.Run("empty workflow", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(workflowsEndpoint, api.WorkflowRequest{})
+		require.NoError(t, err)
+
+		err = srv.ExecuteWorkflow(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
+
+func TestAPI_WorkflowStatus(t *testing.T) {
+	t.Run("nominal case", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		req := api.WorkflowStatusRequest{
+			Id: mocks.GenericWorkflowRecord.ID,
+		}
+
+		rec, ctx, err := setupRecorder(workflowStatusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.WorkflowStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var workflow blockless.WorkflowRecord
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &workflow))
+		require.Equal(t, mocks.GenericWorkflowRecord, workflow)
+	})
+	t.Run("workflow not found", func(t *testing.T) {
+		t.Parallel()
+
+		node := mocks.BaselineNode(t)
+		node.WorkflowFunc = func(context.Context, string) (blockless.WorkflowRecord, error) {
+			return blockless.WorkflowRecord{}, blockless.ErrNotFound
+		}
+
+		srv := api.New(mocks.NoopLogger, node)
+
+		req := api.WorkflowStatusRequest{
+			Id: mocks.GenericWorkflowRecord.ID,
+		}
+
+		rec, ctx, err := setupRecorder(workflowStatusEndpoint, req)
+		require.NoError(t, err)
+
+		err = srv.WorkflowStatus(ctx)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, rec.Result().StatusCode)
+	})
+	t.Run("missing workflow ID", func(t *testing.T) {
+		t.Parallel()
+
+		srv := setupAPI(t)
+
+		_, ctx, err := setupRecorder(workflowStatusEndpoint, api.WorkflowStatusRequest{})
+		require.NoError(t, err)
+
+		err = srv.WorkflowStatus(ctx)
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, echoErr.Code)
+	})
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 )
 
@@ -22,3 +23,20 @@ func getBootNodeAddresses(addrs []string) ([]multiaddr.Multiaddr, error) {
 
 	return out, nil
 }
+
+// get peer IDs from a list of multiaddresses
+func getBootNodeIDs(addrs []string) ([]peer.ID, error) {
+
+	var out []peer.ID
+	for _, addr := range addrs {
+
+		info, err := peer.AddrInfoFromString(addr)
+		if err != nil {
+			return nil, fmt.Errorf("could not get peer ID from multiaddress (addr: %s): %w", addr, err)
+		}
+
+		out = append(out, info.ID)
+	}
+
+	return out, nil
+}
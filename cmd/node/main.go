@@ -10,6 +10,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"slices"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/labstack/echo-contrib/echoprometheus"
@@ -31,6 +32,8 @@ import (
 
 const (
 	defaultLogLevel = zerolog.DebugLevel
+
+	runtimeVersionTimeout = 5 * time.Second
 )
 
 var (
@@ -245,7 +248,7 @@ func run() int {
 		}
 
 	case blockless.HeadNode:
-		node, err = createHeadNode(core, cfg)
+		node, err = createHeadNode(core, store, cfg)
 	}
 	if err != nil {
 		log.Error().Err(err).Msg("could not create node")
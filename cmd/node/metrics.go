@@ -11,6 +11,7 @@ import (
 	"github.com/blocklessnetwork/b7s/fstore"
 	"github.com/blocklessnetwork/b7s/host"
 	"github.com/blocklessnetwork/b7s/node"
+	"github.com/blocklessnetwork/b7s/node/head"
 )
 
 func metricCounters() []mp.CounterDefinition {
@@ -20,6 +21,7 @@ func metricCounters() []mp.CounterDefinition {
 		host.Counters,
 		fstore.Counters,
 		executor.Counters,
+		head.Counters,
 	)
 
 	return counters
@@ -39,7 +41,11 @@ func metricSummaries() []mp.SummaryDefinition {
 
 func metricGauges() []mp.GaugeDefinition {
 
-	// Right now we have a single gauge - node info.
-	gauges := node.Gauges
+	gauges := slices.Concat(
+		node.Gauges,
+		head.Gauges,
+		fstore.Gauges,
+	)
+
 	return gauges
 }
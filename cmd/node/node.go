@@ -9,6 +9,7 @@ import (
 	"github.com/blocklessnetwork/b7s/executor/limits"
 	"github.com/blocklessnetwork/b7s/fstore"
 	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/execute"
 	"github.com/blocklessnetwork/b7s/node"
 	"github.com/blocklessnetwork/b7s/node/head"
 	"github.com/blocklessnetwork/b7s/node/worker"
@@ -20,8 +21,20 @@ type Node interface {
 
 func createWorkerNode(core node.Core, store blockless.Store, cfg *config.Config) (Node, func() error, error) {
 
+	// Function store options.
+	var fstoreOpts []fstore.Option
+	if cfg.Worker.DiskQuotaMB > 0 {
+		fstoreOpts = append(fstoreOpts, fstore.WithDiskQuota(cfg.Worker.DiskQuotaMB*1024*1024))
+	}
+	if cfg.Worker.CollectInterval != 0 {
+		fstoreOpts = append(fstoreOpts, fstore.WithCollectInterval(cfg.Worker.CollectInterval))
+	}
+	if cfg.Worker.StrictCIDVerification {
+		fstoreOpts = append(fstoreOpts, fstore.WithStrictVerification(true))
+	}
+
 	// Create function store.
-	fstore := fstore.New(log.With().Str("component", "fstore").Logger(), store, cfg.Workspace)
+	fstore := fstore.New(log.With().Str("component", "fstore").Logger(), store, cfg.Workspace, fstoreOpts...)
 
 	// Executor options.
 	execOptions := []executor.Option{
@@ -52,10 +65,40 @@ func createWorkerNode(core node.Core, store blockless.Store, cfg *config.Config)
 		return nil, shutdown, fmt.Errorf("could not create an executor: %w", err)
 	}
 
-	worker, err := worker.New(core, fstore, executor,
+	workerOpts := []worker.Option{
 		worker.AttributeLoading(cfg.LoadAttributes),
+		worker.AttributeFile(cfg.Worker.Attributes.File),
+		worker.DeclaredAttributes(cfg.Worker.Attributes.Values),
 		worker.Workspace(cfg.Workspace),
-	)
+		worker.Concurrency(cfg.Concurrency),
+	}
+
+	if len(cfg.Worker.Attributes.Gateways) > 0 {
+		workerOpts = append(workerOpts, worker.AttributeGateways(cfg.Worker.Attributes.Gateways))
+	}
+	if cfg.Worker.Attributes.Timeout != 0 {
+		workerOpts = append(workerOpts, worker.AttributeTimeout(cfg.Worker.Attributes.Timeout))
+	}
+
+	// Only boot nodes are trusted to uninstall functions.
+	heads, err := getBootNodeIDs(cfg.BootNodes)
+	if err != nil {
+		return nil, shutdown, fmt.Errorf("could not determine boot node IDs: %w", err)
+	}
+	workerOpts = append(workerOpts, worker.TrustedHeads(heads...))
+
+	// Runtime version is advertised to head nodes but is not essential.
+	vctx, cancel := context.WithTimeout(context.Background(), runtimeVersionTimeout)
+	defer cancel()
+
+	version, err := executor.RuntimeVersion(vctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("could not determine runtime version")
+	} else {
+		workerOpts = append(workerOpts, worker.RuntimeVersion(version))
+	}
+
+	worker, err := worker.New(core, fstore, executor, workerOpts...)
 	if err != nil {
 		return nil, shutdown, fmt.Errorf("could not create a worker node: %w", err)
 	}
@@ -63,9 +106,35 @@ func createWorkerNode(core node.Core, store blockless.Store, cfg *config.Config)
 	return worker, shutdown, nil
 }
 
-func createHeadNode(core node.Core, cfg *config.Config) (Node, error) {
+func createHeadNode(core node.Core, store blockless.Store, cfg *config.Config) (Node, error) {
+
+	var opts []head.Option
+	if cfg.Head.ResultRetention != 0 {
+		opts = append(opts, head.ResultRetention(cfg.Head.ResultRetention))
+	}
+	if cfg.Head.RollCallWindow != 0 {
+		opts = append(opts, head.RollCallWindow(cfg.Head.RollCallWindow))
+	}
+	if cfg.Head.SelectionStrategy != "" {
+		opts = append(opts, head.DefaultSelection(execute.SelectionStrategy(cfg.Head.SelectionStrategy)))
+	}
+	if cfg.Head.RetryBudget != 0 {
+		opts = append(opts, head.RetryBudget(cfg.Head.RetryBudget))
+	}
+	if cfg.Head.IdempotencyWindow != 0 {
+		opts = append(opts, head.IdempotencyWindow(cfg.Head.IdempotencyWindow))
+	}
+	if cfg.Head.MaxConcurrentExecutions != 0 {
+		opts = append(opts, head.MaxConcurrentExecutions(cfg.Head.MaxConcurrentExecutions))
+	}
+	if cfg.Head.QueueSize != 0 {
+		opts = append(opts, head.QueueSize(cfg.Head.QueueSize))
+	}
+	if cfg.Head.QueueTimeout != 0 {
+		opts = append(opts, head.QueueTimeout(cfg.Head.QueueTimeout))
+	}
 
-	head, err := head.New(core)
+	head, err := head.New(core, store, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not create a head node: %w", err)
 	}
@@ -75,14 +75,34 @@ type Connectivity struct {
 }
 
 type Head struct {
-	RestAPI string `koanf:"rest-api" flag:"rest-api"`
+	RestAPI                 string        `koanf:"rest-api"                  flag:"rest-api"`
+	ResultRetention         time.Duration `koanf:"result-retention"`
+	RollCallWindow          time.Duration `koanf:"roll-call-window"`
+	SelectionStrategy       string        `koanf:"selection-strategy"`
+	RetryBudget             uint          `koanf:"retry-budget"`
+	IdempotencyWindow       time.Duration `koanf:"idempotency-window"`
+	MaxConcurrentExecutions uint          `koanf:"max-concurrent-executions"`
+	QueueSize               uint          `koanf:"queue-size"`
+	QueueTimeout            time.Duration `koanf:"queue-timeout"`
 }
 
 type Worker struct {
-	RuntimePath        string  `koanf:"runtime-path"         flag:"runtime-path"`
-	RuntimeCLI         string  `koanf:"runtime-cli"          flag:"runtime-cli"`
-	CPUPercentageLimit float64 `koanf:"cpu-percentage-limit" flag:"cpu-percentage-limit"`
-	MemoryLimitKB      int64   `koanf:"memory-limit"         flag:"memory-limit"`
+	RuntimePath           string        `koanf:"runtime-path"            flag:"runtime-path"`
+	RuntimeCLI            string        `koanf:"runtime-cli"             flag:"runtime-cli"`
+	CPUPercentageLimit    float64       `koanf:"cpu-percentage-limit"    flag:"cpu-percentage-limit"`
+	MemoryLimitKB         int64         `koanf:"memory-limit"            flag:"memory-limit"`
+	DiskQuotaMB           int64         `koanf:"disk-quota"              flag:"disk-quota"`
+	CollectInterval       time.Duration `koanf:"collect-interval"`
+	StrictCIDVerification bool          `koanf:"strict-cid-verification" flag:"strict-cid-verification"`
+	Attributes            Attributes    `koanf:"attributes"`
+}
+
+// Attributes describes where the worker node loads its attributes from.
+type Attributes struct {
+	File     string            `koanf:"file"     flag:"attributes-file"`
+	Gateways []string          `koanf:"gateways" flag:"attribute-gateways"`
+	Timeout  time.Duration     `koanf:"timeout"`
+	Values   map[string]string `koanf:"values"`
 }
 
 type Telemetry struct {
@@ -168,6 +188,14 @@ func getFlagDescription(flag string) string {
 		return "amount of CPU time allowed for Blockless Functions in the 0-1 range, 1 being unlimited"
 	case "memory-limit":
 		return "memory limit (kB) for Blockless Functions"
+	case "disk-quota":
+		return "disk space (MB) the worker node workspace may use before least recently used functions are evicted"
+	case "strict-cid-verification":
+		return "accept only functions with raw-leaf CIDs, which can be verified against the archive"
+	case "attributes-file":
+		return "local attestation file that the worker node will load its attributes from"
+	case "attribute-gateways":
+		return "IPFS gateways that the worker node will load its attributes from, tried in order"
 	case "no-dialback-peers":
 		return "start without dialing back peers from previous runs"
 	case "must-reach-boot-nodes":
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/require"
@@ -70,6 +71,12 @@ func TestConfig_LoadConfigFile(t *testing.T) {
 		websocket          = false
 		runtimePath        = "/tmp/foo/runtime"
 		cpuPercentageLimit = 0.75
+		diskQuota          = int64(2048)
+		collectInterval    = 10 * time.Minute
+		strictCIDs         = true
+		attributeFile      = "/tmp/foo/attributes.bin"
+		attributeGateways  = []string{"https://{name}.ipns.example.com", "https://example.com"}
+		attributeValues    = map[string]string{"region": "eu", "ram": "16"}
 
 		cfgMap = map[string]any{
 			"role":        role,
@@ -86,8 +93,16 @@ func TestConfig_LoadConfigFile(t *testing.T) {
 				"dialback-port": dialbackPort,
 			},
 			"worker": map[string]any{
-				"runtime-path":         runtimePath,
-				"cpu-percentage-limit": cpuPercentageLimit,
+				"runtime-path":            runtimePath,
+				"cpu-percentage-limit":    cpuPercentageLimit,
+				"disk-quota":              diskQuota,
+				"collect-interval":        collectInterval.String(),
+				"strict-cid-verification": strictCIDs,
+				"attributes": map[string]any{
+					"file":     attributeFile,
+					"gateways": attributeGateways,
+					"values":   attributeValues,
+				},
 			},
 		}
 	)
@@ -109,6 +124,12 @@ func TestConfig_LoadConfigFile(t *testing.T) {
 	require.Equal(t, websocket, cfg.Connectivity.Websocket)
 	require.Equal(t, runtimePath, cfg.Worker.RuntimePath)
 	require.Equal(t, cpuPercentageLimit, cfg.Worker.CPUPercentageLimit)
+	require.Equal(t, diskQuota, cfg.Worker.DiskQuotaMB)
+	require.Equal(t, collectInterval, cfg.Worker.CollectInterval)
+	require.Equal(t, strictCIDs, cfg.Worker.StrictCIDVerification)
+	require.Equal(t, attributeFile, cfg.Worker.Attributes.File)
+	require.Equal(t, attributeGateways, cfg.Worker.Attributes.Gateways)
+	require.Equal(t, attributeValues, cfg.Worker.Attributes.Values)
 }
 
 func TestConfig_CLIArgsWithConfigFile(t *testing.T) {
@@ -34,13 +34,13 @@ func (e *Executor) ExecuteFunction(ctx context.Context, requestID string, req ex
 		}
 	}()
 
-	_, span := e.tracer.Start(ctx, "ExecuteFunction",
+	ctx, span := e.tracer.Start(ctx, "ExecuteFunction",
 		trace.WithSpanKind(trace.SpanKindClient),
 		trace.WithAttributes(tracing.ExecutionAttributes(requestID, req)...))
 	defer span.End()
 
 	// Execute the function.
-	out, usage, err := e.executeFunction(requestID, req)
+	out, usage, err := e.executeFunction(ctx, requestID, req)
 	if err != nil {
 
 		res := execute.Result{
@@ -63,7 +63,7 @@ func (e *Executor) ExecuteFunction(ctx context.Context, requestID string, req ex
 
 // executeFunction handles the actual execution of the Blockless function. It returns the
 // execution information like standard output, standard error, exit code and resource usage.
-func (e *Executor) executeFunction(requestID string, req execute.Request) (execute.RuntimeOutput, execute.Usage, error) {
+func (e *Executor) executeFunction(ctx context.Context, requestID string, req execute.Request) (execute.RuntimeOutput, execute.Usage, error) {
 
 	log := e.log.With().Str("request", requestID).Str("function", req.FunctionID).Logger()
 
@@ -87,7 +87,7 @@ func (e *Executor) executeFunction(requestID string, req execute.Request) (execu
 	log.Debug().Str("dir", paths.workdir).Msg("working directory for the request")
 
 	// Create command that will be executed.
-	cmd := e.createCmd(paths, req)
+	cmd := e.createCmd(ctx, paths, req)
 
 	log.Debug().Int("env_vars_set", len(cmd.Env)).Str("cmd", cmd.String()).Msg("command ready for execution")
 
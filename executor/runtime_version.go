@@ -0,0 +1,28 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// RuntimeVersion returns the version reported by the Blockless Runtime.
+func (e *Executor) RuntimeVersion(ctx context.Context) (string, error) {
+
+	exePath := filepath.Join(e.cfg.RuntimeDir, e.cfg.ExecutableName)
+
+	out, err := exec.CommandContext(ctx, exePath, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("could not get runtime version: %w", err)
+	}
+
+	// Output is typically in the form of "<executable-name> <version>".
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty runtime version output")
+	}
+
+	return fields[len(fields)-1], nil
+}
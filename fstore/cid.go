@@ -0,0 +1,61 @@
+package fstore
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	gocid "github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+// ChecksumCID returns the CID of a function archive with the given SHA-256 checksum (hex encoded).
+// The CID is a raw-leaf CIDv1 of the archive bytes. IPFS assigns the same CID only to archives added as a single raw block,
+// i.e. with raw leaves and no larger than a single chunk (256 KiB by default). Larger archives get a dag-pb CID instead.
+func ChecksumCID(checksum string) (string, error) {
+
+	digest, err := hex.DecodeString(checksum)
+	if err != nil {
+		return "", fmt.Errorf("invalid checksum: %w", err)
+	}
+
+	hash, err := mh.Encode(digest, mh.SHA2_256)
+	if err != nil {
+		return "", fmt.Errorf("could not encode multihash: %w", err)
+	}
+
+	return gocid.NewCidV1(gocid.Raw, hash).String(), nil
+}
+
+// verifyArchive checks that the archive content matches the CID. Only raw-leaf CIDs can be verified - other identifiers,
+// such as dag-pb CIDs of UnixFS DAGs (`bafy...`) or legacy function IDs, cannot be recomputed from the archive alone.
+// Returns true if the content was verified, and an error wrapping `blockless.ErrCIDMismatch` if the content does not match.
+func verifyArchive(cid string, path string) (bool, error) {
+
+	expected, err := gocid.Decode(cid)
+	if err != nil || expected.Type() != gocid.Raw {
+		return false, nil
+	}
+
+	prefix := expected.Prefix()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return false, fmt.Errorf("could not open function archive: %w", err)
+	}
+	defer f.Close()
+
+	hash, err := mh.SumStream(f, prefix.MhType, prefix.MhLength)
+	if err != nil {
+		return false, fmt.Errorf("could not hash function archive: %w", err)
+	}
+
+	actual := gocid.NewCidV1(gocid.Raw, hash)
+	if !actual.Equals(expected) {
+		return false, fmt.Errorf("archive CID %s does not match %s: %w", actual, expected, blockless.ErrCIDMismatch)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,110 @@
+package fstore_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/fstore"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestFunction_ChecksumCID(t *testing.T) {
+
+	// CID of "hello world", as reported by `ipfs add --cid-version 1 --raw-leaves`.
+	const (
+		checksum = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+		expected = "bafkreifzjut3te2nhyekklss27nh3k72ysco7y32koao5eei66wof36n5e"
+	)
+
+	cid, err := fstore.ChecksumCID(checksum)
+	require.NoError(t, err)
+	require.Equal(t, expected, cid)
+
+	_, err = fstore.ChecksumCID("not-a-checksum")
+	require.Error(t, err)
+}
+
+func TestFunction_InstallVerifiesCID(t *testing.T) {
+
+	const (
+		manifestURL = "manifest.json"
+		functionURL = "function.tar.gz"
+		testFile    = "testdata/testFunction.tar.gz"
+	)
+	ctx := context.Background()
+
+	functionPayload, err := os.ReadFile(testFile)
+	require.NoError(t, err)
+
+	msrv, fsrv := createServers(t, manifestURL, functionURL, functionPayload)
+	defer fsrv.Close()
+	defer msrv.Close()
+
+	address := fmt.Sprintf("%s/%v", msrv.URL, manifestURL)
+
+	t.Run("matching CID is installed", func(t *testing.T) {
+		cid, err := fstore.ChecksumCID(fmt.Sprintf("%x", sha256.Sum256(functionPayload)))
+		require.NoError(t, err)
+
+		fh := fstore.New(mocks.NoopLogger, newInMemoryStore(t), t.TempDir())
+
+		err = fh.Install(ctx, address, cid)
+		require.NoError(t, err)
+
+		installed, err := fh.IsInstalled(cid)
+		require.NoError(t, err)
+		require.True(t, installed)
+	})
+	t.Run("mismatched CID is rejected", func(t *testing.T) {
+		cid, err := fstore.ChecksumCID(fmt.Sprintf("%x", sha256.Sum256([]byte("different content"))))
+		require.NoError(t, err)
+
+		workdir := t.TempDir()
+		fh := fstore.New(mocks.NoopLogger, newInMemoryStore(t), workdir)
+
+		err = fh.Install(ctx, address, cid)
+		require.ErrorIs(t, err, blockless.ErrCIDMismatch)
+
+		installed, err := fh.IsInstalled(cid)
+		require.NoError(t, err)
+		require.False(t, installed)
+
+		// Downloaded files are removed.
+		require.NoDirExists(t, filepath.Join(workdir, cid))
+	})
+	t.Run("unverifiable CID is installed by default", func(t *testing.T) {
+		const cid = "dummy-cid"
+
+		fh := fstore.New(mocks.NoopLogger, newInMemoryStore(t), t.TempDir())
+
+		err = fh.Install(ctx, address, cid)
+		require.NoError(t, err)
+
+		installed, err := fh.IsInstalled(cid)
+		require.NoError(t, err)
+		require.True(t, installed)
+	})
+	t.Run("unverifiable CID is rejected with strict verification", func(t *testing.T) {
+		const cid = "dummy-cid"
+
+		workdir := t.TempDir()
+		fh := fstore.New(mocks.NoopLogger, newInMemoryStore(t), workdir, fstore.WithStrictVerification(true))
+
+		err = fh.Install(ctx, address, cid)
+		require.ErrorIs(t, err, blockless.ErrCIDUnverifiable)
+
+		installed, err := fh.IsInstalled(cid)
+		require.NoError(t, err)
+		require.False(t, installed)
+
+		// Downloaded files are removed.
+		require.NoDirExists(t, filepath.Join(workdir, cid))
+	})
+}
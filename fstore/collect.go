@@ -0,0 +1,246 @@
+package fstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+// Pin marks the function as being in use, so it will not be evicted. The returned function releases the pin.
+func (f *FStore) Pin(cid string) func() {
+
+	f.pinLock.Lock()
+	f.pinned[cid]++
+	f.pinLock.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			f.pinLock.Lock()
+			defer f.pinLock.Unlock()
+
+			f.pinned[cid]--
+			if f.pinned[cid] == 0 {
+				delete(f.pinned, cid)
+			}
+		})
+	}
+}
+
+// RunCollector periodically checks the disk usage of the workspace and evicts least recently used functions when over quota.
+// It does nothing if no disk quota is set. It blocks until the context is canceled.
+func (f *FStore) RunCollector(ctx context.Context) {
+
+	if f.cfg.DiskQuota <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(f.cfg.CollectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := f.Collect(ctx)
+			if err != nil {
+				f.log.Error().Err(err).Msg("function collection failed")
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Collect evicts least recently used functions until the workspace is within the disk quota.
+// Functions that are pinned are never evicted.
+func (f *FStore) Collect(ctx context.Context) error {
+
+	if f.cfg.DiskQuota <= 0 {
+		return nil
+	}
+
+	ctx, span := f.tracer.Start(ctx, spanCollect, trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	usage, err := diskUsage(f.workdir)
+	if err != nil {
+		return fmt.Errorf("could not determine workspace disk usage: %w", err)
+	}
+
+	defer func() {
+		f.metrics.SetGauge(workspaceSizeMetric, float32(usage))
+	}()
+
+	if usage <= f.cfg.DiskQuota {
+		return nil
+	}
+
+	f.log.Info().
+		Int64("usage", usage).
+		Int64("quota", f.cfg.DiskQuota).
+		Msg("workspace over disk quota, evicting functions")
+
+	functions, err := f.store.RetrieveFunctions(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve functions: %w", err)
+	}
+
+	// Least recently used functions go first.
+	slices.SortFunc(functions, func(a, b blockless.FunctionRecord) int {
+		if n := lastUsed(a).Compare(lastUsed(b)); n != 0 {
+			return n
+		}
+		return strings.Compare(a.CID, b.CID)
+	})
+
+	var errs []error
+	for _, fn := range functions {
+
+		if usage <= f.cfg.DiskQuota {
+			break
+		}
+
+		size, evicted, err := f.evict(ctx, fn)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not evict function (cid: %s): %w", fn.CID, err))
+			continue
+		}
+		if !evicted {
+			continue
+		}
+
+		usage -= size
+
+		f.metrics.IncrCounter(functionsEvictedMetric, 1)
+		f.metrics.IncrCounter(functionsEvictedSizeMetric, float32(size))
+
+		f.log.Info().
+			Str("cid", fn.CID).
+			Int64("size", size).
+			Time("last_used", lastUsed(fn)).
+			Msg("evicted function")
+	}
+
+	if usage > f.cfg.DiskQuota {
+		f.log.Warn().
+			Int64("usage", usage).
+			Int64("quota", f.cfg.DiskQuota).
+			Msg("workspace still over disk quota after evicting functions")
+	}
+
+	return errors.Join(errs...)
+}
+
+// evict removes the function, unless it's pinned. Returned is the disk space freed and whether the function was evicted.
+func (f *FStore) evict(ctx context.Context, fn blockless.FunctionRecord) (int64, bool, error) {
+
+	// Hold the lock for the duration of the removal, so the function cannot be pinned while it's being removed.
+	f.pinLock.Lock()
+	defer f.pinLock.Unlock()
+
+	if f.pinned[fn.CID] > 0 {
+		return 0, false, nil
+	}
+
+	size, err := f.functionSize(fn)
+	if err != nil {
+		return 0, false, fmt.Errorf("could not determine function size: %w", err)
+	}
+
+	err = f.remove(ctx, fn)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return size, true, nil
+}
+
+// functionSize returns the disk space used by the function archive and the unpacked files.
+func (f *FStore) functionSize(fn blockless.FunctionRecord) (int64, error) {
+
+	var size int64
+
+	files, haveFiles := f.filesPath(fn)
+	if haveFiles {
+		n, err := diskUsage(files)
+		if err != nil {
+			return 0, fmt.Errorf("could not determine size of function files: %w", err)
+		}
+
+		size += n
+	}
+
+	if fn.Archive == "" {
+		return size, nil
+	}
+
+	// Archive is normally found in the function directory and is already accounted for.
+	archive := filepath.Join(f.workdir, fn.Archive)
+	if haveFiles && strings.HasPrefix(archive, files+string(filepath.Separator)) {
+		return size, nil
+	}
+
+	info, err := os.Stat(archive)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, fmt.Errorf("could not stat function archive: %w", err)
+	}
+	if err == nil {
+		size += info.Size()
+	}
+
+	return size, nil
+}
+
+// lastUsed returns the time the function was last retrieved, or last updated if it was never retrieved.
+func lastUsed(fn blockless.FunctionRecord) time.Time {
+	if !fn.LastRetrieved.IsZero() {
+		return fn.LastRetrieved
+	}
+	return fn.UpdatedAt
+}
+
+// diskUsage returns the total size of regular files found under the given path.
+func diskUsage(path string) (int64, error) {
+
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
@@ -0,0 +1,135 @@
+package fstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/fstore"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestFunction_Collect(t *testing.T) {
+
+	const (
+		functionSize = 1000
+	)
+
+	var (
+		ctx = context.Background()
+		now = time.Now().UTC()
+	)
+
+	// Create functions of the same size, each used at a different time. The "oldest" function was used the longest time ago.
+	setup := func(t *testing.T) (string, blockless.FunctionStore, map[string]string) {
+		t.Helper()
+
+		workdir := t.TempDir()
+		store := newInMemoryStore(t)
+
+		functions := map[string]time.Duration{
+			"oldest": 3 * time.Hour,
+			"older":  2 * time.Hour,
+			"recent": time.Hour,
+		}
+
+		dirs := make(map[string]string)
+		for cid, age := range functions {
+
+			dir := filepath.Join(workdir, cid)
+			require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+
+			archive := filepath.Join(dir, "function.tar.gz")
+			require.NoError(t, os.WriteFile(archive, make([]byte, functionSize/2), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "function.wasm"), make([]byte, functionSize/2), 0644))
+
+			fn := blockless.FunctionRecord{
+				CID:           cid,
+				Archive:       filepath.Join(cid, "function.tar.gz"),
+				Files:         cid,
+				LastRetrieved: now.Add(-age),
+			}
+			require.NoError(t, store.SaveFunction(ctx, fn))
+
+			dirs[cid] = dir
+		}
+
+		return workdir, store, dirs
+	}
+
+	t.Run("least recently used function is evicted", func(t *testing.T) {
+
+		workdir, store, dirs := setup(t)
+
+		fh := fstore.New(mocks.NoopLogger, store, workdir, fstore.WithDiskQuota(2*functionSize))
+
+		err := fh.Collect(ctx)
+		require.NoError(t, err)
+
+		require.NoDirExists(t, dirs["oldest"])
+		require.DirExists(t, dirs["older"])
+		require.DirExists(t, dirs["recent"])
+
+		_, err = store.RetrieveFunction(ctx, "oldest")
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+
+		_, err = store.RetrieveFunction(ctx, "older")
+		require.NoError(t, err)
+	})
+	t.Run("pinned function is not evicted", func(t *testing.T) {
+
+		workdir, store, dirs := setup(t)
+
+		// Quota cannot be met even after evicting all functions.
+		fh := fstore.New(mocks.NoopLogger, store, workdir, fstore.WithDiskQuota(functionSize-1))
+
+		release := fh.Pin("oldest")
+
+		err := fh.Collect(ctx)
+		require.NoError(t, err)
+
+		require.DirExists(t, dirs["oldest"])
+		require.NoDirExists(t, dirs["older"])
+		require.NoDirExists(t, dirs["recent"])
+
+		// Once released, the function can be evicted. Releasing more than once is harmless.
+		release()
+		release()
+
+		err = fh.Collect(ctx)
+		require.NoError(t, err)
+
+		require.NoDirExists(t, dirs["oldest"])
+	})
+	t.Run("nothing is evicted when within quota", func(t *testing.T) {
+
+		workdir, store, dirs := setup(t)
+
+		fh := fstore.New(mocks.NoopLogger, store, workdir, fstore.WithDiskQuota(3*functionSize))
+
+		err := fh.Collect(ctx)
+		require.NoError(t, err)
+
+		for _, dir := range dirs {
+			require.DirExists(t, dir)
+		}
+	})
+	t.Run("nothing is evicted without quota", func(t *testing.T) {
+
+		workdir, store, dirs := setup(t)
+
+		fh := fstore.New(mocks.NoopLogger, store, workdir)
+
+		err := fh.Collect(ctx)
+		require.NoError(t, err)
+
+		for _, dir := range dirs {
+			require.DirExists(t, dir)
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package fstore
+
+import (
+	"time"
+)
+
+// DefaultConfig used to create the function store.
+var DefaultConfig = Config{
+	DiskQuota:          0,
+	CollectInterval:    defaultCollectInterval,
+	StrictVerification: false,
+}
+
+// Config represents the function store configuration.
+type Config struct {
+	DiskQuota          int64         // maximum disk space (in bytes) the workspace may use - least recently used functions are evicted when over quota; zero means no limit
+	CollectInterval    time.Duration // how often the workspace disk usage is checked
+	StrictVerification bool          // accept only functions with raw-leaf CIDs, which can be verified against the archive
+}
+
+type Option func(*Config)
+
+// WithDiskQuota sets the disk quota (in bytes) for the workspace.
+func WithDiskQuota(n int64) Option {
+	return func(cfg *Config) {
+		cfg.DiskQuota = n
+	}
+}
+
+// WithCollectInterval sets how often the function store checks the workspace disk usage.
+func WithCollectInterval(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.CollectInterval = d
+	}
+}
+
+// WithStrictVerification sets whether functions whose archive cannot be verified against the CID are refused.
+// Only raw-leaf CIDs can be verified, so strict verification refuses functions with dag-pb CIDs.
+func WithStrictVerification(b bool) Option {
+	return func(cfg *Config) {
+		cfg.StrictVerification = b
+	}
+}
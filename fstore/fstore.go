@@ -24,13 +24,23 @@ type FStore struct {
 
 	functionCount sync.Once
 
+	// Number of running executions using each function. Functions in use are never evicted.
+	pinLock sync.Mutex
+	pinned  map[string]uint
+
+	cfg     Config
 	workdir string
 	tracer  trace.Tracer
 	metrics *metrics.Metrics
 }
 
 // New creates a new function store.
-func New(log zerolog.Logger, store blockless.FunctionStore, workdir string) *FStore {
+func New(log zerolog.Logger, store blockless.FunctionStore, workdir string, options ...Option) *FStore {
+
+	cfg := DefaultConfig
+	for _, option := range options {
+		option(&cfg)
+	}
 
 	// Create an HTTP client.
 	cli := &http.Client{
@@ -48,6 +58,8 @@ func New(log zerolog.Logger, store blockless.FunctionStore, workdir string) *FSt
 		store:      store,
 		http:       cli,
 		downloader: downloader,
+		pinned:     make(map[string]uint),
+		cfg:        cfg,
 		workdir:    workdir,
 		tracer:     otel.Tracer(tracerName),
 		metrics:    metrics.Default(),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -59,6 +60,12 @@ func (f *FStore) Install(ctx context.Context, address string, cid string) (retEr
 
 	out := filepath.Join(f.workdir, cid)
 
+	// Make sure the archive is what the CID says it is.
+	err = f.verifyDownload(cid, functionPath, out)
+	if err != nil {
+		return fmt.Errorf("could not verify function archive: %w", err)
+	}
+
 	// Unpack the .tar.gz archive.
 	// TODO: Would be good to know the content of the .tar.gz archive.
 	// We're unpacking the archive here and storing the path to the .tar.gz in the DB.
@@ -117,3 +124,32 @@ func (f *FStore) IsInstalled(cid string) (bool, error) {
 	// We have the function in the database and all files - we're good.
 	return true, nil
 }
+
+// verifyDownload verifies the downloaded archive against the CID. Downloaded files are removed if the content does not match.
+// With strict verification, only functions with raw-leaf CIDs are accepted - functions with any other CID, including
+// dag-pb CIDs IPFS assigns to most archives, cannot be verified and are refused.
+func (f *FStore) verifyDownload(cid string, archive string, dir string) error {
+
+	verified, err := verifyArchive(cid, archive)
+	if err == nil && !verified {
+
+		if !f.cfg.StrictVerification {
+			f.log.Warn().Str("cid", cid).Msg("function CID is not a raw-leaf CID and cannot be verified, archive verified by manifest checksum only")
+			return nil
+		}
+
+		err = fmt.Errorf("strict verification accepts only raw-leaf CIDs: %w", blockless.ErrCIDUnverifiable)
+	}
+	if err != nil {
+		if errors.Is(err, blockless.ErrCIDMismatch) || errors.Is(err, blockless.ErrCIDUnverifiable) {
+			rmErr := os.RemoveAll(dir)
+			if rmErr != nil {
+				f.log.Warn().Err(rmErr).Str("cid", cid).Str("dir", dir).Msg("could not remove downloaded function")
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ const (
 	defaultTimeout   = 10 * time.Second
 	defaultUserAgent = "b7s"
 
+	defaultCollectInterval = 5 * time.Minute
+
 	tracerName = "b7s.Fstore"
 )
 
@@ -18,6 +20,8 @@ const (
 	spanInstall     = "FunctionInstall"
 	spanIsInstalled = "IsFunctionInstalled"
 	spanSync        = "FunctionSync"
+	spanUninstall   = "FunctionUninstall"
+	spanCollect     = "FunctionCollect"
 )
 
 var (
@@ -26,6 +30,10 @@ var (
 	functionsInstalledErrMetric   = []string{"fstore", "functions", "installed", "err"}
 	functionsInstallTimeMetric    = []string{"fstore", "functions", "installation", "milliseconds"}
 	functionsDownloadedSizeMetric = []string{"fstore", "functions", "installed", "size", "bytes"}
+	functionsUninstalledMetric    = []string{"fstore", "functions", "uninstalled"}
+	functionsEvictedMetric        = []string{"fstore", "functions", "evicted"}
+	functionsEvictedSizeMetric    = []string{"fstore", "functions", "evicted", "size", "bytes"}
+	workspaceSizeMetric           = []string{"fstore", "workspace", "size", "bytes"}
 )
 
 var Counters = []prometheus.CounterDefinition{
@@ -45,6 +53,25 @@ var Counters = []prometheus.CounterDefinition{
 		Name: functionsDownloadedSizeMetric,
 		Help: "Total size of (compressed) functions installed by the node in this session.",
 	},
+	{
+		Name: functionsUninstalledMetric,
+		Help: "Number of functions uninstalled from this node in this session.",
+	},
+	{
+		Name: functionsEvictedMetric,
+		Help: "Number of functions evicted from this node to stay within the disk quota in this session.",
+	},
+	{
+		Name: functionsEvictedSizeMetric,
+		Help: "Total size of functions evicted from this node to stay within the disk quota in this session.",
+	},
+}
+
+var Gauges = []prometheus.GaugeDefinition{
+	{
+		Name: workspaceSizeMetric,
+		Help: "Disk space used by the workspace, as last measured by the function store.",
+	},
 }
 
 var Summaries = []prometheus.SummaryDefinition{
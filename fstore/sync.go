@@ -88,6 +88,11 @@ func (f *FStore) sync(ctx context.Context, fn blockless.FunctionRecord) error {
 			return fmt.Errorf("could not download the function archive (cid: %v): %w", fn.CID, err)
 		}
 
+		err = f.verifyDownload(fn.CID, path, filepath.Join(f.workdir, fn.CID))
+		if err != nil {
+			return fmt.Errorf("could not verify function archive (cid: %v): %w", fn.CID, err)
+		}
+
 		// Update path in case it changed.
 		fn.Archive = f.cleanPath(path)
 	}
@@ -0,0 +1,95 @@
+package fstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/telemetry/b7ssemconv"
+)
+
+// Uninstall removes the function identified by the CID - its archive, the unpacked files and the function record.
+// If the function is not installed, the returned error wraps `blockless.ErrNotFound`. Functions that are pinned
+// are not removed and the returned error wraps `blockless.ErrFunctionInUse`.
+func (f *FStore) Uninstall(ctx context.Context, cid string) error {
+
+	ctx, span := f.tracer.Start(ctx, spanUninstall, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(b7ssemconv.FunctionCID.String(cid)))
+	defer span.End()
+
+	// Hold the lock for the duration of the removal, so the function cannot be pinned while it's being removed.
+	f.pinLock.Lock()
+	defer f.pinLock.Unlock()
+
+	if f.pinned[cid] > 0 {
+		return fmt.Errorf("function is pinned (cid: %s): %w", cid, blockless.ErrFunctionInUse)
+	}
+
+	// Read the function directly from storage - getting the function would update its timestamp and save it again.
+	fn, err := f.store.RetrieveFunction(ctx, cid)
+	if err != nil {
+		return fmt.Errorf("could not retrieve function record: %w", err)
+	}
+
+	f.log.Debug().
+		Str("cid", cid).
+		Str("archive", fn.Archive).
+		Str("files", fn.Files).
+		Msg("uninstalling function")
+
+	err = f.remove(ctx, fn)
+	if err != nil {
+		return err
+	}
+
+	f.metrics.IncrCounter(functionsUninstalledMetric, 1)
+
+	f.log.Debug().
+		Str("cid", cid).
+		Msg("uninstalled function")
+
+	return nil
+}
+
+// remove deletes the function archive, the unpacked files and the function record.
+func (f *FStore) remove(ctx context.Context, fn blockless.FunctionRecord) error {
+
+	// Archive is normally found in the function directory, but it may have been moved.
+	if fn.Archive != "" {
+		archive := filepath.Join(f.workdir, fn.Archive)
+		err := os.Remove(archive)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("could not remove function archive (file: %s): %w", archive, err)
+		}
+	}
+
+	files, ok := f.filesPath(fn)
+	if ok {
+		err := os.RemoveAll(files)
+		if err != nil {
+			return fmt.Errorf("could not remove function files (dir: %s): %w", files, err)
+		}
+	}
+
+	err := f.store.RemoveFunction(ctx, fn.CID)
+	if err != nil {
+		return fmt.Errorf("could not remove function record: %w", err)
+	}
+
+	return nil
+}
+
+// filesPath returns the full path of the unpacked function files. It returns false if the path would point to the
+// workdir itself, so we never touch the entire workdir because of a missing path in the function record.
+func (f *FStore) filesPath(fn blockless.FunctionRecord) (string, bool) {
+
+	files := filepath.Join(f.workdir, fn.Files)
+	if filepath.Clean(files) == filepath.Clean(f.workdir) {
+		return "", false
+	}
+
+	return files, true
+}
@@ -0,0 +1,137 @@
+package fstore_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/fstore"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestFunction_Uninstall(t *testing.T) {
+
+	const (
+		manifestURL = "manifest.json"
+		functionURL = "function.tar.gz"
+		testFile    = "testdata/testFunction.tar.gz"
+
+		testCID = "dummy-cid"
+	)
+	ctx := context.Background()
+
+	workdir, err := os.MkdirTemp("", "b7s-function-uninstall-")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(workdir)
+
+	functionPayload, err := os.ReadFile(testFile)
+	require.NoError(t, err)
+
+	msrv, fsrv := createServers(t, manifestURL, functionURL, functionPayload)
+	defer fsrv.Close()
+	defer msrv.Close()
+
+	store := newInMemoryStore(t)
+	fh := fstore.New(mocks.NoopLogger, store, workdir)
+
+	address := fmt.Sprintf("%s/%v", msrv.URL, manifestURL)
+	err = fh.Install(ctx, address, testCID)
+	require.NoError(t, err)
+
+	// Read the record directly from the store, as reading it via the function store updates it in the background.
+	function, err := store.RetrieveFunction(ctx, testCID)
+	require.NoError(t, err)
+
+	archive := filepath.Join(workdir, function.Archive)
+	files := filepath.Join(workdir, function.Files)
+	require.FileExists(t, archive)
+	require.DirExists(t, files)
+
+	t.Run("pinned function is not uninstalled", func(t *testing.T) {
+
+		release := fh.Pin(testCID)
+
+		err = fh.Uninstall(ctx, testCID)
+		require.ErrorIs(t, err, blockless.ErrFunctionInUse)
+
+		require.FileExists(t, archive)
+		require.DirExists(t, files)
+
+		_, err = store.RetrieveFunction(ctx, testCID)
+		require.NoError(t, err)
+
+		release()
+	})
+	t.Run("function uninstall works", func(t *testing.T) {
+
+		err = fh.Uninstall(ctx, testCID)
+		require.NoError(t, err)
+
+		require.NoFileExists(t, archive)
+		require.NoDirExists(t, files)
+		require.DirExists(t, workdir)
+
+		_, err = store.RetrieveFunction(ctx, testCID)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+
+		installed, err := fh.IsInstalled(testCID)
+		require.NoError(t, err)
+		require.False(t, installed)
+	})
+	t.Run("uninstalling missing function fails", func(t *testing.T) {
+
+		err = fh.Uninstall(ctx, testCID)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+}
+
+func TestFunction_UninstallHandlesErrors(t *testing.T) {
+
+	const (
+		testCID = "dummy-cid"
+	)
+
+	t.Run("handles store error", func(t *testing.T) {
+		t.Parallel()
+
+		workdir, err := os.MkdirTemp("", "b7s-function-uninstall-")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(workdir)
+
+		store := mocks.BaselineStore(t)
+		store.RemoveFunctionFunc = func(context.Context, string) error {
+			return mocks.GenericError
+		}
+
+		fh := fstore.New(mocks.NoopLogger, store, workdir)
+
+		err = fh.Uninstall(context.Background(), testCID)
+		require.ErrorIs(t, err, mocks.GenericError)
+	})
+	t.Run("does not remove workdir for records without files", func(t *testing.T) {
+		t.Parallel()
+
+		workdir, err := os.MkdirTemp("", "b7s-function-uninstall-")
+		require.NoError(t, err)
+
+		defer os.RemoveAll(workdir)
+
+		store := mocks.BaselineStore(t)
+		store.RetrieveFunctionFunc = func(context.Context, string) (blockless.FunctionRecord, error) {
+			return blockless.FunctionRecord{CID: testCID}, nil
+		}
+
+		fh := fstore.New(mocks.NoopLogger, store, workdir)
+
+		err = fh.Uninstall(context.Background(), testCID)
+		require.NoError(t, err)
+		require.DirExists(t, workdir)
+	})
+}
@@ -79,11 +79,15 @@ func instantiateNode(t *testing.T, nodeDir string, role blockless.NodeRole) *nod
 	// If we're creating a head node - we have everything we need.
 	if role == blockless.HeadNode {
 
-		headNode, err := head.New(core)
+		db, err := pebble.Open(filepath.Join(dir, "db"), &pebble.Options{})
+		require.NoError(t, err)
+
+		headNode, err := head.New(core, store.New(db, codec.NewJSONCodec()))
 		require.NoError(t, err)
 
 		return &nodeScaffolding{
 			dir:     dir,
+			db:      db,
 			logFile: logFile,
 			host:    host,
 			node:    headNode,
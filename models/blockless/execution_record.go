@@ -0,0 +1,26 @@
+package blockless
+
+import (
+	"time"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// ExecutionRecord describes the outcome of an execution request, as persisted by the head node.
+type ExecutionRecord struct {
+	RequestID  string            `json:"request_id"`
+	FunctionID string            `json:"function_id"`
+	Code       codes.Code        `json:"code"`
+	Results    execute.ResultMap `json:"results,omitempty"`
+	Cluster    execute.Cluster   `json:"cluster,omitempty"`
+
+	// Aggregation requested for the execution results.
+	Aggregation execute.ResultAggregation `json:"aggregation,omitempty"`
+
+	// Used to communicate the reason for failure to the user.
+	Message string `json:"message,omitempty"`
+
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
@@ -0,0 +1,45 @@
+package blockless
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// FunctionJobKind describes what the function job asks the workers to do.
+type FunctionJobKind string
+
+const (
+	JobInstall   FunctionJobKind = "install"
+	JobUninstall FunctionJobKind = "uninstall"
+)
+
+// JobState describes the outcome of a function job on a worker.
+type JobState string
+
+const (
+	JobSucceeded JobState = "succeeded"
+	JobFailed    JobState = "failed"
+)
+
+// FunctionJob tracks the installation or removal of a function published to the workers in a topic.
+// Since the request is broadcast, only peers that responded are known.
+type FunctionJob struct {
+	ID          string          `json:"id"`
+	Kind        FunctionJobKind `json:"kind"`
+	CID         string          `json:"cid"`
+	ManifestURL string          `json:"manifest_url,omitempty"`
+	Topic       string          `json:"topic"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Succeeded   uint            `json:"succeeded"` // Number of peers that completed the job.
+	Failed      uint            `json:"failed"`    // Number of peers that failed to complete the job.
+	Peers       []PeerJobStatus `json:"peers"`
+}
+
+// PeerJobStatus describes the outcome of a function job on a single worker.
+type PeerJobStatus struct {
+	Peer      peer.ID   `json:"peer"`
+	State     JobState  `json:"state"`
+	Error     string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
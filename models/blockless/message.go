@@ -10,18 +10,21 @@ type Message interface {
 
 // Message types in the Blockless protocol.
 const (
-	MessageHealthCheck             = "MsgHealthCheck"
-	MessageInstallFunction         = "MsgInstallFunction"
-	MessageInstallFunctionResponse = "MsgInstallFunctionResponse"
-	MessageRollCall                = "MsgRollCall"
-	MessageRollCallResponse        = "MsgRollCallResponse"
-	MessageExecute                 = "MsgExecute" // MessageExecute is the execution request, as expected by the head node.
-	MessageExecuteResponse         = "MsgExecuteResponse"
-	MessageWorkOrder               = "MsgWorkOrder" // MessageWorkOrder is the execution request, as expected by the worker node.
-	MessageWorkOrderResponse       = "MsgWorkOrderResponse"
-	MessageFormCluster             = "MsgFormCluster"
-	MessageFormClusterResponse     = "MsgFormClusterResponse"
-	MessageDisbandCluster          = "MsgDisbandCluster"
+	MessageHealthCheck               = "MsgHealthCheck"
+	MessageInstallFunction           = "MsgInstallFunction"
+	MessageInstallFunctionResponse   = "MsgInstallFunctionResponse"
+	MessageUninstallFunction         = "MsgUninstallFunction"
+	MessageUninstallFunctionResponse = "MsgUninstallFunctionResponse"
+	MessageRollCall                  = "MsgRollCall"
+	MessageRollCallResponse          = "MsgRollCallResponse"
+	MessageExecute                   = "MsgExecute" // MessageExecute is the execution request, as expected by the head node.
+	MessageExecuteResponse           = "MsgExecuteResponse"
+	MessageWorkOrder                 = "MsgWorkOrder" // MessageWorkOrder is the execution request, as expected by the worker node.
+	MessageWorkOrderResponse         = "MsgWorkOrderResponse"
+	MessageFormCluster               = "MsgFormCluster"
+	MessageFormClusterResponse       = "MsgFormClusterResponse"
+	MessageDisbandCluster            = "MsgDisbandCluster"
+	MessageCancelWorkOrder           = "MsgCancelWorkOrder"
 )
 
 type TraceableMessage interface {
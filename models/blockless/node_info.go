@@ -0,0 +1,34 @@
+package blockless
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// NodeInfo describes the node.
+type NodeInfo struct {
+	ID        string   `json:"id"`
+	Role      string   `json:"role"`
+	Version   string   `json:"version"`
+	Addresses []string `json:"addresses"`
+	Topics    []string `json:"topics"` // Topics the node is subscribed to.
+}
+
+// ConnectedPeer describes a peer the node is currently connected to.
+type ConnectedPeer struct {
+	ID        peer.ID  `json:"id"`
+	Addresses []string `json:"addresses"`
+}
+
+// InstalledFunction describes a function along with the workers that reported having it installed.
+type InstalledFunction struct {
+	CID     string           `json:"cid"`
+	Workers []FunctionWorker `json:"workers"`
+}
+
+// FunctionWorker describes a worker that reported having a function installed.
+type FunctionWorker struct {
+	Peer       peer.ID   `json:"peer"`
+	ReportedAt time.Time `json:"reported_at"` // Time of the most recent report.
+}
@@ -9,9 +9,18 @@ import (
 
 // Sentinel errors.
 var (
-	ErrNotFound                = errors.New("not found")
-	ErrRollCallTimeout         = errors.New("roll call timed out - not enough nodes responded")
-	ErrExecutionNotEnoughNodes = errors.New("not enough execution results received")
+	ErrNotFound                 = errors.New("not found")
+	ErrRollCallTimeout          = errors.New("roll call timed out - not enough nodes responded")
+	ErrExecutionNotEnoughNodes  = errors.New("not enough execution results received")
+	ErrExecutionCanceled        = errors.New("execution canceled")
+	ErrIdempotencyKeyConflict   = errors.New("idempotency key already used for a different request")
+	ErrExecutionQueueFull       = errors.New("execution queue full")
+	ErrExecutionQueueTimeout    = errors.New("timed out waiting in execution queue")
+	ErrShardFailed              = errors.New("shard execution failed")
+	ErrNotEnoughMatchingResults = errors.New("not enough matching execution results from distinct replicas")
+	ErrCIDMismatch              = errors.New("content does not match CID")
+	ErrCIDUnverifiable          = errors.New("content cannot be verified against CID")
+	ErrFunctionInUse            = errors.New("function in use")
 )
 
 const (
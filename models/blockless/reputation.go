@@ -0,0 +1,43 @@
+package blockless
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// PeerReputation describes the track record of a worker, as observed by the head node.
+type PeerReputation struct {
+	Peer peer.ID `json:"peer"`
+
+	Succeeded uint64 `json:"succeeded"` // Work orders that produced a successful result.
+	Failed    uint64 `json:"failed"`    // Work orders that failed or produced a failed result.
+	TimedOut  uint64 `json:"timed_out"` // Work orders that produced no result in time.
+
+	Agreed    uint64 `json:"agreed"`    // Results that agreed with the majority of the results for the same request.
+	Disagreed uint64 `json:"disagreed"` // Results that differed from the majority.
+
+	// Time between sending the work order and receiving the result, averaged over the measured responses.
+	MeasuredResponses uint64        `json:"measured_responses"`
+	AverageLatency    time.Duration `json:"average_latency"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// referenceLatency is the average response latency at which the peer score is halved.
+const referenceLatency = 10 * time.Second
+
+// Score returns the reputation score of the peer in the (0, 1) range. It takes into account the share of successful
+// work orders, the share of results agreeing with the majority and the average response latency.
+// Peers without history get a neutral score.
+func (r PeerReputation) Score() float64 {
+
+	// Additive smoothing so that a couple of executions do not determine the score entirely.
+	reliability := float64(r.Succeeded+1) / float64(r.Succeeded+r.Failed+r.TimedOut+2)
+	agreement := float64(r.Agreed+1) / float64(r.Agreed+r.Disagreed+2)
+
+	// Slower peers get a lower score.
+	responsiveness := float64(referenceLatency) / float64(referenceLatency+max(r.AverageLatency, 0))
+
+	return reliability * agreement * responsiveness
+}
@@ -0,0 +1,34 @@
+package blockless
+
+import (
+	"time"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// ScheduleRecord describes a recurring function execution, as persisted by the head node.
+type ScheduleRecord struct {
+	ID      string          `json:"id"`
+	Cron    string          `json:"cron"`    // Cron expression determining when the function is executed.
+	Request execute.Request `json:"request"` // Template for the execution request issued on each run.
+	Topic   string          `json:"topic,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	NextRun   time.Time `json:"next_run"`
+
+	// Most recent runs of the schedule, oldest first.
+	History []ScheduleRun `json:"history,omitempty"`
+}
+
+// ScheduleRun describes a single run of a scheduled execution.
+type ScheduleRun struct {
+	RequestID string     `json:"request_id"`
+	Code      codes.Code `json:"code"`
+
+	// Used to communicate the reason for failure to the user.
+	Message string `json:"message,omitempty"`
+
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
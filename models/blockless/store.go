@@ -9,6 +9,10 @@ import (
 type Store interface {
 	PeerStore
 	FunctionStore
+	ExecutionResultStore
+	ScheduleStore
+	WorkflowStore
+	ReputationStore
 }
 
 type PeerStore interface {
@@ -24,3 +28,29 @@ type FunctionStore interface {
 	RetrieveFunctions(ctx context.Context) ([]FunctionRecord, error)
 	RemoveFunction(ctx context.Context, id string) error
 }
+
+type ExecutionResultStore interface {
+	SaveExecutionResult(ctx context.Context, record ExecutionRecord) error
+	RetrieveExecutionResult(ctx context.Context, id string) (ExecutionRecord, error)
+	RetrieveExecutionResults(ctx context.Context) ([]ExecutionRecord, error)
+	RemoveExecutionResult(ctx context.Context, id string) error
+}
+
+type ScheduleStore interface {
+	SaveSchedule(ctx context.Context, schedule ScheduleRecord) error
+	RetrieveSchedule(ctx context.Context, id string) (ScheduleRecord, error)
+	RetrieveSchedules(ctx context.Context) ([]ScheduleRecord, error)
+	RemoveSchedule(ctx context.Context, id string) error
+}
+
+type WorkflowStore interface {
+	SaveWorkflow(ctx context.Context, workflow WorkflowRecord) error
+	RetrieveWorkflow(ctx context.Context, id string) (WorkflowRecord, error)
+	RetrieveWorkflows(ctx context.Context) ([]WorkflowRecord, error)
+}
+
+type ReputationStore interface {
+	SaveReputation(ctx context.Context, reputation PeerReputation) error
+	RetrieveReputation(ctx context.Context, id peer.ID) (PeerReputation, error)
+	RetrieveReputations(ctx context.Context) ([]PeerReputation, error)
+}
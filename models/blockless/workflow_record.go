@@ -0,0 +1,40 @@
+package blockless
+
+import (
+	"time"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// WorkflowRecord describes the progress of a workflow, as persisted by the head node.
+type WorkflowRecord struct {
+	ID       string           `json:"id"`
+	Workflow execute.Workflow `json:"workflow"`
+	Topic    string           `json:"topic,omitempty"`
+	State    execute.State    `json:"state"`
+
+	// Progress of the individual steps, in the order of the workflow definition.
+	Steps []WorkflowStepRecord `json:"steps"`
+
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+}
+
+// WorkflowStepRecord describes the progress of a single workflow step.
+type WorkflowStepRecord struct {
+	ID        string            `json:"id"`
+	State     execute.StepState `json:"state"`
+	RequestID string            `json:"request_id,omitempty"` // ID of the execution request issued for the step.
+	Code      codes.Code        `json:"code,omitempty"`
+
+	// Aggregated output of the step execution.
+	Stdout   string `json:"stdout,omitempty"`
+	ExitCode int    `json:"exit_code"`
+
+	// Used to communicate the reason for failure or skipping to the user.
+	Message string `json:"message,omitempty"`
+
+	StartedAt   time.Time `json:"started_at,omitempty"`
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+}
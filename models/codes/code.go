@@ -10,11 +10,14 @@ const (
 	NoContent      Code = "204"
 	PartialContent Code = "206"
 
-	Invalid       Code = "400"
-	NotAuthorized Code = "401"
-	NotPermitted  Code = "403"
-	NotFound      Code = "404"
-	Timeout       Code = "408"
+	Invalid         Code = "400"
+	NotAuthorized   Code = "401"
+	NotPermitted    Code = "403"
+	NotFound        Code = "404"
+	Timeout         Code = "408"
+	NoConsensus     Code = "409"
+	TooManyRequests Code = "429"
+	Canceled        Code = "499"
 
 	Error          Code = "500"
 	NotImplemented Code = "501"
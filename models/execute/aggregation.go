@@ -0,0 +1,167 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+)
+
+// AggregationType determines how the execution results from multiple peers are combined.
+type AggregationType string
+
+const (
+	// AggregateExact groups results with identical output. This is the default.
+	AggregateExact AggregationType = "exact"
+	// AggregateMajority returns the result with identical output from enough peers, as determined by the agreement ratio.
+	AggregateMajority AggregationType = "majority"
+	// AggregateMedian returns the median of numeric outputs.
+	AggregateMedian AggregationType = "median"
+	// AggregateMean returns the mean of numeric outputs.
+	AggregateMean AggregationType = "mean"
+	// AggregateJSONField groups results by the value of a field in the JSON output.
+	AggregateJSONField AggregationType = "json-field"
+	// AggregateFastest returns the successful result that took the least time to execute.
+	AggregateFastest AggregationType = "fastest"
+	// AggregateWeightedMajority is like the majority vote, but results are weighted by the reputation of the peers that returned them.
+	AggregateWeightedMajority AggregationType = "weighted-majority"
+)
+
+// Result aggregation parameters.
+const (
+	// AggregationRatio is the minimum portion of results (0-1] that should agree for the majority vote.
+	// For the weighted majority vote, it is the minimum portion of the total weight.
+	// If not set, more than half of the results should agree.
+	AggregationRatio = "ratio"
+	// AggregationField is the JSONPath expression selecting the field used for JSON-field based comparison.
+	AggregationField = "field"
+)
+
+func (t AggregationType) String() string {
+	return string(t)
+}
+
+// Valid returns true if the aggregation type is known. Empty type is valid and means the default type will be used.
+func (t AggregationType) Valid() bool {
+	switch t {
+	case "", AggregateExact, AggregateMajority, AggregateMedian, AggregateMean, AggregateJSONField, AggregateFastest, AggregateWeightedMajority:
+		return true
+	default:
+		return false
+	}
+}
+
+// ResultAggregation describes how the execution results from multiple peers should be combined.
+type ResultAggregation struct {
+	Enable     bool            `json:"enable,omitempty"`
+	Type       AggregationType `json:"type,omitempty"`
+	Parameters []Parameter     `json:"parameters,omitempty"`
+
+	// Normalization determines which results are considered equivalent. Applies even if aggregation is not enabled.
+	Normalization ResultNormalization `json:"normalization,omitempty"`
+}
+
+// ResultNormalization describes how execution outputs are normalized before they are compared.
+type ResultNormalization struct {
+	IgnoreStderr   bool `json:"ignore_stderr,omitempty"`
+	IgnoreExitCode bool `json:"ignore_exit_code,omitempty"`
+
+	// Trim leading and trailing whitespace from stdout and stderr.
+	TrimWhitespace bool `json:"trim_whitespace,omitempty"`
+
+	// Compare stdout as JSON, ignoring formatting and the order of object members.
+	CanonicalJSON bool `json:"canonical_json,omitempty"`
+
+	// JSONPath expression selecting the part of the JSON stdout that is compared, e.g. `$.data.value`.
+	Field string `json:"field,omitempty"`
+}
+
+// Strategy returns the aggregation type that should be used. Unless aggregation is enabled, identical outputs are grouped.
+func (a ResultAggregation) Strategy() AggregationType {
+
+	if !a.Enable || a.Type == "" {
+		return AggregateExact
+	}
+
+	return a.Type
+}
+
+// Parameter returns the value of the aggregation parameter with the given name.
+func (a ResultAggregation) Parameter(name string) (string, bool) {
+
+	for _, param := range a.Parameters {
+		if param.Name == name {
+			return param.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Ratio returns the minimum agreement ratio for the majority vote, if set.
+func (a ResultAggregation) Ratio() (float64, bool, error) {
+
+	value, ok := a.Parameter(AggregationRatio)
+	if !ok {
+		return 0, false, nil
+	}
+
+	ratio, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("could not parse agreement ratio: %w", err)
+	}
+
+	if ratio <= 0 || ratio > 1 {
+		return 0, false, fmt.Errorf("agreement ratio should be in range (0, 1] (have: %v)", ratio)
+	}
+
+	return ratio, true, nil
+}
+
+func (a ResultAggregation) Valid() error {
+
+	if !a.Type.Valid() {
+		return fmt.Errorf("unknown aggregation type (%s)", a.Type)
+	}
+
+	switch a.Strategy() {
+	case AggregateMajority, AggregateWeightedMajority:
+		_, _, err := a.Ratio()
+		if err != nil {
+			return err
+		}
+
+	case AggregateJSONField:
+		field, _ := a.Parameter(AggregationField)
+		if field == "" {
+			return errors.New("field is required for JSON-field aggregation")
+		}
+
+		err := validateField(field)
+		if err != nil {
+			return fmt.Errorf("invalid field: %w", err)
+		}
+	}
+
+	if a.Normalization.Field != "" {
+		err := validateField(a.Normalization.Field)
+		if err != nil {
+			return fmt.Errorf("invalid normalization field: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateField checks that the field is a JSONPath expression rooted at the document.
+func validateField(field string) error {
+
+	if !strings.HasPrefix(strings.TrimSpace(field), "$") {
+		return errors.New("path must start with $")
+	}
+
+	_, err := jsonpath.New(field)
+	return err
+}
@@ -0,0 +1,66 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultAggregation_Valid(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		cfg   ResultAggregation
+		valid bool
+	}{
+		{name: "default", cfg: ResultAggregation{}, valid: true},
+		{name: "mean", cfg: ResultAggregation{Enable: true, Type: AggregateMean}, valid: true},
+		{name: "unknown type", cfg: ResultAggregation{Enable: true, Type: "mode"}, valid: false},
+		{name: "unknown type when disabled", cfg: ResultAggregation{Type: "mode"}, valid: false},
+		{
+			name:  "majority with ratio",
+			cfg:   ResultAggregation{Enable: true, Type: AggregateMajority, Parameters: []Parameter{{Name: AggregationRatio, Value: "0.66"}}},
+			valid: true,
+		},
+		{
+			name:  "majority with invalid ratio",
+			cfg:   ResultAggregation{Enable: true, Type: AggregateMajority, Parameters: []Parameter{{Name: AggregationRatio, Value: "1.5"}}},
+			valid: false,
+		},
+		{
+			name:  "json field",
+			cfg:   ResultAggregation{Enable: true, Type: AggregateJSONField, Parameters: []Parameter{{Name: AggregationField, Value: "$.data.value"}}},
+			valid: true,
+		},
+		{name: "json field without field", cfg: ResultAggregation{Enable: true, Type: AggregateJSONField}, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestResultNormalization_Valid(t *testing.T) {
+
+	cfg := ResultAggregation{
+		Normalization: ResultNormalization{Field: "$.data.items[0]"},
+	}
+	require.NoError(t, cfg.Valid())
+
+	cfg.Normalization.Field = `$["data"]["odd.key"]`
+	require.NoError(t, cfg.Valid())
+
+	cfg.Normalization.Field = "$.data["
+	require.Error(t, cfg.Valid())
+
+	// Expressions must be rooted at the document.
+	cfg.Normalization.Field = "data.items"
+	require.Error(t, cfg.Valid())
+}
@@ -0,0 +1,94 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// BatchRequest describes a request to execute the same function for many sets of inputs.
+type BatchRequest struct {
+	FunctionID string      `json:"function_id"`
+	Method     string      `json:"method"`
+	Config     Config      `json:"config"`
+	Items      []BatchItem `json:"items"`
+}
+
+// BatchItem is a single set of inputs for the function. Inputs set here override the ones set in the batch config.
+type BatchItem struct {
+	Parameters  []Parameter `json:"parameters,omitempty"`
+	Environment []EnvVar    `json:"env_vars,omitempty"`
+	Stdin       *string     `json:"stdin,omitempty"`
+}
+
+// BatchItemResult is the outcome of the execution for a single batch item.
+type BatchItemResult struct {
+	Index  int        `json:"index"` // Index of the item in the batch request.
+	Peer   peer.ID    `json:"peer,omitempty"`
+	Result NodeResult `json:"result"`
+}
+
+func (b BatchRequest) Valid() error {
+
+	var err *multierror.Error
+
+	if b.FunctionID == "" {
+		err = multierror.Append(err, errors.New("function ID is required"))
+	}
+
+	if b.Method == "" {
+		err = multierror.Append(err, errors.New("method is required"))
+	}
+
+	if len(b.Items) == 0 {
+		err = multierror.Append(err, errors.New("at least one batch item is required"))
+	}
+
+	// Each item is executed by a single worker, so there's nothing to reach consensus on.
+	if b.Config.ConsensusAlgorithm != "" {
+		err = multierror.Append(err, errors.New("consensus is not supported for batch execution"))
+	}
+
+	if !b.Config.SelectionStrategy.Valid() {
+		err = multierror.Append(err, fmt.Errorf("unknown selection strategy (%s)", b.Config.SelectionStrategy))
+	}
+
+	aerr := b.Config.ResultAggregation.Valid()
+	if aerr != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid result aggregation: %w", aerr))
+	}
+
+	if b.Config.Attributes != nil {
+		aerr := b.Config.Attributes.Valid()
+		if aerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid attributes: %w", aerr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// Request returns the execution request for the batch item with the given index.
+func (b BatchRequest) Request(i int) Request {
+
+	item := b.Items[i]
+
+	req := Request{
+		FunctionID: b.FunctionID,
+		Method:     b.Method,
+		Parameters: item.Parameters,
+		Config:     b.Config,
+	}
+
+	if len(item.Environment) > 0 {
+		req.Config.Environment = item.Environment
+	}
+
+	if item.Stdin != nil {
+		req.Config.Stdin = item.Stdin
+	}
+
+	return req
+}
@@ -0,0 +1,21 @@
+package execute
+
+// NodeCapacity describes how much work a worker node can take on at the moment.
+type NodeCapacity struct {
+	FreeSlots         uint    `json:"free_slots"`                // How many more executions the node can run in parallel.
+	RunningExecutions uint    `json:"running_executions"`        // How many executions the node is running.
+	CPUPressure       float64 `json:"cpu_pressure"`              // Recent CPU load, relative to the number of CPUs.
+	MemoryPressure    float64 `json:"memory_pressure"`           // Portion of the system memory in use.
+	RuntimeVersion    string  `json:"runtime_version,omitempty"` // Version of the Blockless Runtime.
+}
+
+// Utilization returns the share of the execution slots in use.
+func (c NodeCapacity) Utilization() float64 {
+
+	total := c.FreeSlots + c.RunningExecutions
+	if total == 0 {
+		return 0
+	}
+
+	return float64(c.RunningExecutions) / float64(total)
+}
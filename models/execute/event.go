@@ -0,0 +1,41 @@
+package execute
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+)
+
+// EventType describes the kind of progress reported for an execution request.
+type EventType string
+
+// Execution event types.
+const (
+	EventState    EventType = "state"     // Execution moved to a new state.
+	EventRollCall EventType = "roll-call" // Peer reported for the roll call.
+	EventResult   EventType = "result"    // Peer sent its execution result.
+	EventDone     EventType = "done"      // Execution is complete.
+)
+
+func (t EventType) String() string {
+	return string(t)
+}
+
+// Event describes progress of an execution request. Which fields are set depends on the event type.
+type Event struct {
+	Type      EventType
+	RequestID string
+
+	// Set for state events.
+	State State
+
+	// Set for roll call and result events.
+	Peer   peer.ID
+	Result NodeResult
+
+	// Set for done events.
+	Code    codes.Code
+	Results ResultMap
+	Cluster Cluster
+	Message string
+}
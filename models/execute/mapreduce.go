@@ -0,0 +1,223 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// MapReduceRequest describes a request where the input is split into shards, each processed by a different worker (map),
+// after which the reduce function is executed over the collected shard outputs to produce the final result.
+type MapReduceRequest struct {
+	FunctionID string      `json:"function_id"`
+	Method     string      `json:"method"`
+	Parameters []Parameter `json:"parameters,omitempty"` // Parameters passed to each shard, before the sharded ones.
+	Config     Config      `json:"config"`
+
+	Input MapInput `json:"input"`
+
+	// Number of shards the input is split into. If not set, the input is split across all workers selected for the execution.
+	Shards int `json:"shards,omitempty"`
+
+	Reduce ReduceFunction `json:"reduce"`
+}
+
+// MapInput is the input that is split into shards. Either standard input or parameters should be set.
+type MapInput struct {
+	Stdin      string      `json:"stdin,omitempty"`      // Split line by line.
+	Parameters []Parameter `json:"parameters,omitempty"` // Split into contiguous ranges.
+}
+
+// ReduceFunction describes the function invoked over the collected shard outputs.
+type ReduceFunction struct {
+	FunctionID string      `json:"function_id"`
+	Method     string      `json:"method"`
+	Parameters []Parameter `json:"parameters,omitempty"`
+	Config     Config      `json:"config"`
+}
+
+// MapReduceResult is the outcome of a map-reduce execution.
+type MapReduceResult struct {
+	Shards  []BatchItemResult `json:"shards"`            // Results of the map phase, in shard order.
+	Results ResultMap         `json:"results,omitempty"` // Results of the reduce function.
+	Cluster Cluster           `json:"cluster,omitempty"` // Peers that executed the reduce function.
+}
+
+func (r MapReduceRequest) Valid() error {
+
+	var err *multierror.Error
+
+	if r.FunctionID == "" {
+		err = multierror.Append(err, errors.New("function ID is required"))
+	}
+
+	if r.Method == "" {
+		err = multierror.Append(err, errors.New("method is required"))
+	}
+
+	if r.Input.Stdin != "" && len(r.Input.Parameters) > 0 {
+		err = multierror.Append(err, errors.New("input should be either standard input or parameters, not both"))
+	}
+
+	if r.Input.size() == 0 {
+		err = multierror.Append(err, errors.New("input is required"))
+	}
+
+	if r.Shards < 0 {
+		err = multierror.Append(err, fmt.Errorf("invalid shard count (%d)", r.Shards))
+	}
+
+	// Each shard is executed by a single worker, so there's nothing to reach consensus on.
+	if r.Config.ConsensusAlgorithm != "" {
+		err = multierror.Append(err, errors.New("consensus is not supported for the map phase"))
+	}
+
+	if !r.Config.SelectionStrategy.Valid() {
+		err = multierror.Append(err, fmt.Errorf("unknown selection strategy (%s)", r.Config.SelectionStrategy))
+	}
+
+	if r.Config.Attributes != nil {
+		aerr := r.Config.Attributes.Valid()
+		if aerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid attributes: %w", aerr))
+		}
+	}
+
+	if r.Reduce.FunctionID == "" {
+		err = multierror.Append(err, errors.New("reduce function ID is required"))
+	}
+
+	if r.Reduce.Method == "" {
+		err = multierror.Append(err, errors.New("reduce method is required"))
+	}
+
+	if !r.Reduce.Config.SelectionStrategy.Valid() {
+		err = multierror.Append(err, fmt.Errorf("unknown reduce selection strategy (%s)", r.Reduce.Config.SelectionStrategy))
+	}
+
+	aerr := r.Reduce.Config.ResultAggregation.Valid()
+	if aerr != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid reduce result aggregation: %w", aerr))
+	}
+
+	if r.Reduce.Config.Attributes != nil {
+		aerr := r.Reduce.Config.Attributes.Valid()
+		if aerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid reduce attributes: %w", aerr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// Map splits the input into (at most) n shards and returns the batch request executing the function once for each shard.
+// Shards are contiguous ranges of the input, so concatenating the shard outputs preserves the input order.
+func (r MapReduceRequest) Map(n int) BatchRequest {
+
+	var shards [][]string
+	if len(r.Input.Parameters) > 0 {
+		values := make([]string, 0, len(r.Input.Parameters))
+		for _, param := range r.Input.Parameters {
+			values = append(values, param.Value)
+		}
+		shards = split(values, n)
+	} else {
+		shards = split(r.Input.lines(), n)
+	}
+
+	batch := BatchRequest{
+		FunctionID: r.FunctionID,
+		Method:     r.Method,
+		Config:     r.Config,
+		Items:      make([]BatchItem, 0, len(shards)),
+	}
+
+	// Shard parameters keep their names, so slice the original list instead of recreating them from the values.
+	var offset int
+	for _, shard := range shards {
+
+		item := BatchItem{
+			Parameters: slices.Clone(r.Parameters),
+		}
+
+		if len(r.Input.Parameters) > 0 {
+			item.Parameters = append(item.Parameters, r.Input.Parameters[offset:offset+len(shard)]...)
+		} else {
+			stdin := strings.Join(shard, "")
+			item.Stdin = &stdin
+		}
+
+		offset += len(shard)
+		batch.Items = append(batch.Items, item)
+	}
+
+	return batch
+}
+
+// ReduceRequest returns the execution request for the reduce function. Shard outputs are concatenated in shard order,
+// each terminated by a newline, and passed to the reduce function as standard input.
+func (r MapReduceRequest) ReduceRequest(outputs []string) Request {
+
+	var stdin strings.Builder
+	for _, output := range outputs {
+		stdin.WriteString(output)
+		if !strings.HasSuffix(output, "\n") {
+			stdin.WriteString("\n")
+		}
+	}
+
+	input := stdin.String()
+
+	req := Request{
+		FunctionID: r.Reduce.FunctionID,
+		Method:     r.Reduce.Method,
+		Parameters: r.Reduce.Parameters,
+		Config:     r.Reduce.Config,
+	}
+	req.Config.Stdin = &input
+
+	return req
+}
+
+// size returns the number of units the input can be split into.
+func (i MapInput) size() int {
+	if len(i.Parameters) > 0 {
+		return len(i.Parameters)
+	}
+
+	return len(i.lines())
+}
+
+// lines returns the lines of the standard input, each with its line terminator preserved.
+func (i MapInput) lines() []string {
+	if i.Stdin == "" {
+		return nil
+	}
+
+	return strings.SplitAfter(strings.TrimSuffix(i.Stdin, "\n"), "\n")
+}
+
+// split divides the values into n contiguous chunks of roughly equal size. There are never more chunks than values.
+func split(values []string, n int) [][]string {
+
+	n = max(min(n, len(values)), 1)
+
+	chunks := make([][]string, 0, n)
+	size, rem := len(values)/n, len(values)%n
+
+	var start int
+	for i := 0; i < n; i++ {
+		end := start + size
+		if i < rem {
+			end++
+		}
+
+		chunks = append(chunks, values[start:end])
+		start = end
+	}
+
+	return chunks
+}
@@ -0,0 +1,114 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapReduceRequest_Map(t *testing.T) {
+
+	t.Run("standard input", func(t *testing.T) {
+
+		req := MapReduceRequest{
+			FunctionID: "function-id",
+			Method:     "method",
+			Parameters: []Parameter{{Value: "--count"}},
+			Input:      MapInput{Stdin: "a\nb\nc\nd\ne\n"},
+		}
+
+		batch := req.Map(2)
+		require.Equal(t, req.FunctionID, batch.FunctionID)
+		require.Equal(t, req.Method, batch.Method)
+		require.Len(t, batch.Items, 2)
+
+		require.Equal(t, "a\nb\nc\n", *batch.Items[0].Stdin)
+		require.Equal(t, "d\ne", *batch.Items[1].Stdin)
+
+		for _, item := range batch.Items {
+			require.Equal(t, req.Parameters, item.Parameters)
+		}
+	})
+	t.Run("parameters", func(t *testing.T) {
+
+		req := MapReduceRequest{
+			FunctionID: "function-id",
+			Method:     "method",
+			Parameters: []Parameter{{Value: "--count"}},
+			Input: MapInput{
+				Parameters: []Parameter{{Value: "a"}, {Value: "b"}, {Name: "named", Value: "c"}},
+			},
+		}
+
+		batch := req.Map(3)
+		require.Len(t, batch.Items, 3)
+
+		require.Equal(t, []Parameter{{Value: "--count"}, {Value: "a"}}, batch.Items[0].Parameters)
+		require.Equal(t, []Parameter{{Value: "--count"}, {Value: "b"}}, batch.Items[1].Parameters)
+		require.Equal(t, []Parameter{{Value: "--count"}, {Name: "named", Value: "c"}}, batch.Items[2].Parameters)
+		require.Nil(t, batch.Items[0].Stdin)
+	})
+	t.Run("more shards than input", func(t *testing.T) {
+
+		req := MapReduceRequest{
+			Input: MapInput{Stdin: "a\nb"},
+		}
+
+		require.Len(t, req.Map(10).Items, 2)
+		require.Len(t, req.Map(0).Items, 1)
+	})
+}
+
+func TestMapReduceRequest_ReduceRequest(t *testing.T) {
+
+	req := MapReduceRequest{
+		Reduce: ReduceFunction{
+			FunctionID: "reduce-function-id",
+			Method:     "reduce-method",
+			Parameters: []Parameter{{Value: "--sum"}},
+		},
+	}
+
+	reduce := req.ReduceRequest([]string{"1\n", "2"})
+	require.Equal(t, "reduce-function-id", reduce.FunctionID)
+	require.Equal(t, "reduce-method", reduce.Method)
+	require.Equal(t, req.Reduce.Parameters, reduce.Parameters)
+	require.NotNil(t, reduce.Config.Stdin)
+	require.Equal(t, "1\n2\n", *reduce.Config.Stdin)
+}
+
+func TestMapReduceRequest_Valid(t *testing.T) {
+
+	valid := MapReduceRequest{
+		FunctionID: "function-id",
+		Method:     "method",
+		Input:      MapInput{Stdin: "a\nb"},
+		Reduce: ReduceFunction{
+			FunctionID: "reduce-function-id",
+			Method:     "reduce-method",
+		},
+	}
+
+	require.NoError(t, valid.Valid())
+
+	t.Run("no input", func(t *testing.T) {
+		req := valid
+		req.Input = MapInput{}
+		require.Error(t, req.Valid())
+	})
+	t.Run("both inputs set", func(t *testing.T) {
+		req := valid
+		req.Input.Parameters = []Parameter{{Value: "a"}}
+		require.Error(t, req.Valid())
+	})
+	t.Run("no reduce function", func(t *testing.T) {
+		req := valid
+		req.Reduce = ReduceFunction{}
+		require.Error(t, req.Valid())
+	})
+	t.Run("consensus for map phase", func(t *testing.T) {
+		req := valid
+		req.Config.ConsensusAlgorithm = "raft"
+		require.Error(t, req.Valid())
+	})
+}
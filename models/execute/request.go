@@ -2,6 +2,7 @@ package execute
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/go-multierror"
 )
@@ -15,6 +16,9 @@ type Request struct {
 
 	// Optional signature of the request.
 	Signature string `json:"signature,omitempty"`
+
+	// Optional key identifying the request. Repeated submissions with the same key do not start new executions.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 func (r Request) Valid() error {
@@ -29,6 +33,22 @@ func (r Request) Valid() error {
 		err = multierror.Append(err, errors.New("method is required"))
 	}
 
+	if !r.Config.SelectionStrategy.Valid() {
+		err = multierror.Append(err, fmt.Errorf("unknown selection strategy (%s)", r.Config.SelectionStrategy))
+	}
+
+	aerr := r.Config.ResultAggregation.Valid()
+	if aerr != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid result aggregation: %w", aerr))
+	}
+
+	if r.Config.Attributes != nil {
+		aerr := r.Config.Attributes.Valid()
+		if aerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid attributes: %w", aerr))
+		}
+	}
+
 	return err.ErrorOrNil()
 }
 
@@ -59,6 +79,12 @@ type Config struct {
 
 	// Threshold (percentage) defines how many nodes should respond with a result to consider this execution successful.
 	Threshold float64 `json:"threshold,omitempty"`
+
+	// Priority of the request in the execution queue of the head node. Requests with higher priority are executed first.
+	Priority int `json:"priority,omitempty"`
+
+	// Strategy used to choose workers among the peers that reported for the roll call.
+	SelectionStrategy SelectionStrategy `json:"selection_strategy,omitempty"`
 }
 
 // EnvVar represents the name and value of the environment variables set for the execution.
@@ -66,9 +92,3 @@ type EnvVar struct {
 	Name  string `json:"name,omitempty"`
 	Value string `json:"value,omitempty"`
 }
-
-type ResultAggregation struct {
-	Enable     bool        `json:"enable,omitempty"`
-	Type       string      `json:"type,omitempty"`
-	Parameters []Parameter `json:"parameters,omitempty"`
-}
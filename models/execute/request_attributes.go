@@ -1,14 +1,23 @@
 package execute
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/go-multierror"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 type Attributes struct {
-	// Values specify which attributes the node in question should have.
-	// At the moment we support strict equality only, so no `if RAM >= 16GB` types of conditions.
+	// Values specify which attributes the node in question should have, with strict equality.
 	Values []Parameter `json:"values,omitempty"`
 
+	// Conditions specify typed conditions the node attributes should satisfy, e.g. `RAM >= 16`.
+	// All conditions should be satisfied.
+	Conditions []AttributeCondition `json:"conditions,omitempty"`
+
 	// Should we accept nodes whose attributes are not attested?
 	AttestationRequired bool `json:"attestation_required,omitempty"`
 
@@ -23,3 +32,230 @@ type AttributeAttestors struct {
 	// Any one of these attestors should be found.
 	OneOf []peer.ID `json:"one_of,omitempty"`
 }
+
+// AttributeOperator determines how the attribute value is compared.
+type AttributeOperator string
+
+const (
+	// AttributeEqual requires the attribute value to be equal to the condition value. This is the default.
+	AttributeEqual AttributeOperator = "eq"
+	// AttributeNotEqual requires the attribute value to differ from the condition value.
+	AttributeNotEqual AttributeOperator = "ne"
+	// AttributeGreaterThan requires the numeric attribute value to be greater than the condition value.
+	AttributeGreaterThan AttributeOperator = "gt"
+	// AttributeGreaterOrEqual requires the numeric attribute value to be greater than or equal to the condition value.
+	AttributeGreaterOrEqual AttributeOperator = "gte"
+	// AttributeLessThan requires the numeric attribute value to be less than the condition value.
+	AttributeLessThan AttributeOperator = "lt"
+	// AttributeLessOrEqual requires the numeric attribute value to be less than or equal to the condition value.
+	AttributeLessOrEqual AttributeOperator = "lte"
+	// AttributeIn requires the attribute value to be one of the condition values.
+	AttributeIn AttributeOperator = "in"
+	// AttributeNotIn requires the attribute value to be none of the condition values.
+	AttributeNotIn AttributeOperator = "not-in"
+	// AttributeSemver requires the attribute value to be a semantic version within the range given as the condition value, e.g. `>=1.2.0 <2.0.0`.
+	AttributeSemver AttributeOperator = "semver"
+	// AttributeExists requires the attribute to be present, regardless of its value.
+	AttributeExists AttributeOperator = "exists"
+)
+
+func (o AttributeOperator) String() string {
+	return string(o)
+}
+
+// Valid returns true if the operator is known. Empty operator is valid and means equality.
+func (o AttributeOperator) Valid() bool {
+	switch o {
+	case "", AttributeEqual, AttributeNotEqual,
+		AttributeGreaterThan, AttributeGreaterOrEqual, AttributeLessThan, AttributeLessOrEqual,
+		AttributeIn, AttributeNotIn, AttributeSemver, AttributeExists:
+		return true
+	default:
+		return false
+	}
+}
+
+// AttributeCondition describes a condition a single node attribute should satisfy.
+type AttributeCondition struct {
+	Name     string            `json:"name"`
+	Operator AttributeOperator `json:"operator,omitempty"`
+
+	// Value the attribute is compared to. Used by the comparison and semver operators.
+	Value string `json:"value,omitempty"`
+
+	// Values the attribute is compared to. Used by the `in` and `not-in` operators.
+	Values []string `json:"values,omitempty"`
+
+	// Negate inverts the outcome of the condition, e.g. `exists` with negation requires the attribute to be missing.
+	Negate bool `json:"negate,omitempty"`
+}
+
+// Valid checks if the attributes are well-formed.
+func (a Attributes) Valid() error {
+
+	var err *multierror.Error
+	for i, cond := range a.Conditions {
+		cerr := cond.Valid()
+		if cerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid condition %d: %w", i, cerr))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// Valid checks if the condition is well-formed.
+func (c AttributeCondition) Valid() error {
+
+	if c.Name == "" {
+		return errors.New("attribute name is required")
+	}
+
+	switch c.Operator {
+	case "", AttributeEqual, AttributeNotEqual, AttributeExists:
+		return nil
+
+	case AttributeGreaterThan, AttributeGreaterOrEqual, AttributeLessThan, AttributeLessOrEqual:
+		_, err := strconv.ParseFloat(c.Value, 64)
+		if err != nil {
+			return fmt.Errorf("numeric value required (value: %s)", c.Value)
+		}
+		return nil
+
+	case AttributeIn, AttributeNotIn:
+		if len(c.Values) == 0 {
+			return errors.New("values are required")
+		}
+		return nil
+
+	case AttributeSemver:
+		_, err := semver.NewConstraint(c.Value)
+		if err != nil {
+			return fmt.Errorf("invalid version range: %w", err)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown operator (%s)", c.Operator)
+	}
+}
+
+// Match checks if the attribute satisfies the condition. Found reports whether the node has the attribute at all.
+func (c AttributeCondition) Match(value string, found bool) error {
+
+	err := c.match(value, found)
+
+	if c.Negate {
+		if err == nil {
+			return fmt.Errorf("negated condition satisfied (attr: %s, operator: %s)", c.Name, c.operator())
+		}
+		return nil
+	}
+
+	return err
+}
+
+func (c AttributeCondition) match(value string, found bool) error {
+
+	op := c.operator()
+
+	if op == AttributeExists {
+		if !found {
+			return fmt.Errorf("attribute wanted but not found (attr: %s)", c.Name)
+		}
+		return nil
+	}
+
+	if !found {
+		return fmt.Errorf("attribute wanted but not found (attr: %s, operator: %s)", c.Name, op)
+	}
+
+	switch op {
+	case AttributeEqual:
+		if value != c.Value {
+			return fmt.Errorf("attribute value doesn't match (attr: %s, want: %s, have: %s)", c.Name, c.Value, value)
+		}
+
+	case AttributeNotEqual:
+		if value == c.Value {
+			return fmt.Errorf("attribute value matches excluded value (attr: %s, value: %s)", c.Name, value)
+		}
+
+	case AttributeGreaterThan, AttributeGreaterOrEqual, AttributeLessThan, AttributeLessOrEqual:
+		return c.compareNumbers(value)
+
+	case AttributeIn:
+		for _, v := range c.Values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("attribute value not in set (attr: %s, want: %v, have: %s)", c.Name, c.Values, value)
+
+	case AttributeNotIn:
+		for _, v := range c.Values {
+			if v == value {
+				return fmt.Errorf("attribute value in excluded set (attr: %s, value: %s)", c.Name, value)
+			}
+		}
+
+	case AttributeSemver:
+		r, err := semver.NewConstraint(c.Value)
+		if err != nil {
+			return fmt.Errorf("invalid version range (attr: %s): %w", c.Name, err)
+		}
+
+		version, err := semver.NewVersion(value)
+		if err != nil {
+			return fmt.Errorf("attribute value is not a version (attr: %s, value: %s): %w", c.Name, value, err)
+		}
+
+		if !r.Check(version) {
+			return fmt.Errorf("attribute version not in range (attr: %s, range: %s, have: %s)", c.Name, c.Value, value)
+		}
+
+	default:
+		return fmt.Errorf("unknown operator (attr: %s, operator: %s)", c.Name, op)
+	}
+
+	return nil
+}
+
+func (c AttributeCondition) compareNumbers(value string) error {
+
+	want, err := strconv.ParseFloat(c.Value, 64)
+	if err != nil {
+		return fmt.Errorf("condition value is not numeric (attr: %s, value: %s)", c.Name, c.Value)
+	}
+
+	have, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("attribute value is not numeric (attr: %s, value: %s)", c.Name, value)
+	}
+
+	var ok bool
+	switch c.operator() {
+	case AttributeGreaterThan:
+		ok = have > want
+	case AttributeGreaterOrEqual:
+		ok = have >= want
+	case AttributeLessThan:
+		ok = have < want
+	case AttributeLessOrEqual:
+		ok = have <= want
+	}
+
+	if !ok {
+		return fmt.Errorf("attribute value doesn't satisfy condition (attr: %s, condition: %s %s, have: %s)", c.Name, c.operator(), c.Value, value)
+	}
+
+	return nil
+}
+
+// operator returns the operator that should be used. Equality is the default.
+func (c AttributeCondition) operator() AttributeOperator {
+	if c.Operator == "" {
+		return AttributeEqual
+	}
+	return c.Operator
+}
@@ -0,0 +1,83 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeCondition_Valid(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		cond  AttributeCondition
+		valid bool
+	}{
+		{name: "default operator", cond: AttributeCondition{Name: "region", Value: "eu"}, valid: true},
+		{name: "numeric comparison", cond: AttributeCondition{Name: "ram", Operator: AttributeGreaterOrEqual, Value: "16"}, valid: true},
+		{name: "set", cond: AttributeCondition{Name: "region", Operator: AttributeNotIn, Values: []string{"us", "ap"}}, valid: true},
+		{name: "semver range", cond: AttributeCondition{Name: "version", Operator: AttributeSemver, Value: ">=1.2.0 <2.0.0"}, valid: true},
+		{name: "existence", cond: AttributeCondition{Name: "gpu", Operator: AttributeExists, Negate: true}, valid: true},
+		{name: "missing name", cond: AttributeCondition{Value: "eu"}, valid: false},
+		{name: "unknown operator", cond: AttributeCondition{Name: "ram", Operator: "approx", Value: "16"}, valid: false},
+		{name: "non-numeric comparison", cond: AttributeCondition{Name: "ram", Operator: AttributeLessThan, Value: "lots"}, valid: false},
+		{name: "empty set", cond: AttributeCondition{Name: "region", Operator: AttributeIn}, valid: false},
+		{name: "invalid semver range", cond: AttributeCondition{Name: "version", Operator: AttributeSemver, Value: ">=one"}, valid: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			err := test.cond.Valid()
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAttributeCondition_Match(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		cond  AttributeCondition
+		value string
+		found bool
+		match bool
+	}{
+		{name: "equal", cond: AttributeCondition{Name: "region", Value: "eu"}, value: "eu", found: true, match: true},
+		{name: "not equal", cond: AttributeCondition{Name: "region", Operator: AttributeNotEqual, Value: "eu"}, value: "eu", found: true, match: false},
+		{name: "greater than", cond: AttributeCondition{Name: "ram", Operator: AttributeGreaterThan, Value: "16"}, value: "32", found: true, match: true},
+		{name: "greater than - equal", cond: AttributeCondition{Name: "ram", Operator: AttributeGreaterThan, Value: "16"}, value: "16", found: true, match: false},
+		{name: "greater or equal", cond: AttributeCondition{Name: "ram", Operator: AttributeGreaterOrEqual, Value: "16"}, value: "16", found: true, match: true},
+		{name: "less than", cond: AttributeCondition{Name: "load", Operator: AttributeLessThan, Value: "0.5"}, value: "0.75", found: true, match: false},
+		{name: "less or equal", cond: AttributeCondition{Name: "load", Operator: AttributeLessOrEqual, Value: "0.5"}, value: "0.5", found: true, match: true},
+		{name: "non-numeric value", cond: AttributeCondition{Name: "ram", Operator: AttributeGreaterThan, Value: "16"}, value: "lots", found: true, match: false},
+		{name: "in", cond: AttributeCondition{Name: "region", Operator: AttributeIn, Values: []string{"eu", "us"}}, value: "us", found: true, match: true},
+		{name: "not in", cond: AttributeCondition{Name: "region", Operator: AttributeNotIn, Values: []string{"eu", "us"}}, value: "us", found: true, match: false},
+		{name: "semver", cond: AttributeCondition{Name: "version", Operator: AttributeSemver, Value: "^1.2.0"}, value: "v1.4.2", found: true, match: true},
+		{name: "semver out of range", cond: AttributeCondition{Name: "version", Operator: AttributeSemver, Value: "^1.2.0"}, value: "2.0.0", found: true, match: false},
+		{name: "semver alternatives", cond: AttributeCondition{Name: "version", Operator: AttributeSemver, Value: "~1.2 || >=3.0.0"}, value: "3.1.0", found: true, match: true},
+		{name: "semver prerelease", cond: AttributeCondition{Name: "version", Operator: AttributeSemver, Value: ">=1.2.0"}, value: "1.3.0-beta.1", found: true, match: false},
+		{name: "semver invalid version", cond: AttributeCondition{Name: "version", Operator: AttributeSemver, Value: ">=1.2.0"}, value: "latest", found: true, match: false},
+		{name: "exists", cond: AttributeCondition{Name: "gpu", Operator: AttributeExists}, found: true, match: true},
+		{name: "missing", cond: AttributeCondition{Name: "gpu", Operator: AttributeExists}, found: false, match: false},
+		{name: "missing with comparison", cond: AttributeCondition{Name: "ram", Operator: AttributeLessThan, Value: "16"}, found: false, match: false},
+		{name: "negated existence", cond: AttributeCondition{Name: "gpu", Operator: AttributeExists, Negate: true}, found: false, match: true},
+		{name: "negated comparison", cond: AttributeCondition{Name: "ram", Operator: AttributeLessThan, Value: "16", Negate: true}, value: "8", found: true, match: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			err := test.cond.Match(test.value, test.found)
+			if test.match {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -19,7 +19,7 @@ type NodeResult struct {
 
 	Signature string         `json:"signature,omitempty"` // Signed digest of the response.
 	PBFT      PBFTResultInfo `json:"pbft,omitempty"`
-	Metadata  any            `json:"metadata,omitempty"`
+	Metadata  any            `json:"metadata,omitempty"` // Decoded metadata is kept in its original JSON encoding (json.RawMessage).
 }
 
 // Result describes an execution result.
@@ -33,6 +33,9 @@ type Result struct {
 type Cluster struct {
 	Main  peer.ID   `json:"main,omitempty"`
 	Peers []peer.ID `json:"peers,omitempty"`
+
+	// Peers whose results were dropped because their signature could not be verified.
+	Unverified []peer.ID `json:"unverified,omitempty"`
 }
 
 // RuntimeOutput describes the output produced by the Blockless Runtime during execution.
@@ -75,13 +78,34 @@ func (m ResultMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(em)
 }
 
-func (r *NodeResult) Sign(key crypto.PrivKey) error {
+// UnmarshalJSON keeps the metadata in its original JSON encoding. Decoding it into a generic value could change
+// the key order and number formatting, so the signature of the result could no longer be verified.
+func (r *NodeResult) UnmarshalJSON(data []byte) error {
 
-	cp := *r
-	// Exclude some of the fields from the signature.
-	cp.Signature = ""
+	type Alias NodeResult
+	rec := struct {
+		*Alias
+		Metadata json.RawMessage `json:"metadata,omitempty"`
+	}{
+		Alias: (*Alias)(r),
+	}
+
+	err := json.Unmarshal(data, &rec)
+	if err != nil {
+		return err
+	}
+
+	r.Metadata = nil
+	if len(rec.Metadata) > 0 && string(rec.Metadata) != "null" {
+		r.Metadata = rec.Metadata
+	}
+
+	return nil
+}
+
+func (r *NodeResult) Sign(key crypto.PrivKey) error {
 
-	payload, err := json.Marshal(cp)
+	payload, err := r.signingPayload()
 	if err != nil {
 		return fmt.Errorf("could not get byte representation of the record: %w", err)
 	}
@@ -97,11 +121,7 @@ func (r *NodeResult) Sign(key crypto.PrivKey) error {
 
 func (r NodeResult) VerifySignature(key crypto.PubKey) error {
 
-	cp := r
-	// Exclude some of the fields from the signature.
-	cp.Signature = ""
-
-	payload, err := json.Marshal(cp)
+	payload, err := r.signingPayload()
 	if err != nil {
 		return fmt.Errorf("could not get byte representation of the record: %w", err)
 	}
@@ -122,3 +142,31 @@ func (r NodeResult) VerifySignature(key crypto.PubKey) error {
 
 	return nil
 }
+
+// signingPayload returns the byte representation of the result that is signed. Metadata is included in its JSON encoding,
+// as sent over the wire, so that the payload is the same for the peer signing the result and the one verifying it.
+func (r NodeResult) signingPayload() ([]byte, error) {
+
+	cp := r
+	// Exclude some of the fields from the signature.
+	cp.Signature = ""
+
+	if cp.Metadata != nil {
+		raw, ok := cp.Metadata.(json.RawMessage)
+		if !ok {
+			var err error
+			raw, err = json.Marshal(cp.Metadata)
+			if err != nil {
+				return nil, fmt.Errorf("could not encode metadata: %w", err)
+			}
+		}
+
+		// Metadata without a value is not included, same as when decoding the result.
+		cp.Metadata = nil
+		if string(raw) != "null" {
+			cp.Metadata = raw
+		}
+	}
+
+	return json.Marshal(cp)
+}
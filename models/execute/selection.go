@@ -0,0 +1,25 @@
+package execute
+
+// SelectionStrategy determines how the head node chooses workers among the peers that reported for the roll call.
+type SelectionStrategy string
+
+const (
+	// SelectFirst chooses the first peers that reported for the roll call.
+	SelectFirst SelectionStrategy = "first"
+	// SelectRandom chooses peers randomly from the ones that reported for the roll call.
+	SelectRandom SelectionStrategy = "random"
+	// SelectLeastLoaded chooses the peers with the least amount of work in progress.
+	SelectLeastLoaded SelectionStrategy = "least-loaded"
+	// SelectReputation chooses the peers with the best track record.
+	SelectReputation SelectionStrategy = "reputation"
+)
+
+// Valid returns true if the selection strategy is known. Empty strategy is valid and means the default strategy will be used.
+func (s SelectionStrategy) Valid() bool {
+	switch s {
+	case "", SelectFirst, SelectRandom, SelectLeastLoaded, SelectReputation:
+		return true
+	default:
+		return false
+	}
+}
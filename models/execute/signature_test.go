@@ -1,6 +1,7 @@
 package execute
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/blocklessnetwork/b7s/models/codes"
@@ -107,6 +108,51 @@ func TestResultExecute_Signing(t *testing.T) {
 	})
 }
 
+func TestResultExecute_SigningWithMetadata(t *testing.T) {
+
+	// Field order differs from the sorted key order of a decoded map, and the number does not fit a float64.
+	type metadata struct {
+		Zeta  string `json:"zeta"`
+		Alpha uint64 `json:"alpha"`
+	}
+
+	res := NodeResult{
+		Result: Result{
+			Code: codes.OK,
+			Result: RuntimeOutput{
+				Stdout: "generic-execution-result",
+			},
+		},
+		Metadata: metadata{
+			Zeta:  "value",
+			Alpha: 18446744073709551615,
+		},
+	}
+
+	priv, pub := newKey(t)
+
+	err := res.Sign(priv)
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(res)
+	require.NoError(t, err)
+
+	// Result received by a different peer.
+	var received NodeResult
+	err = json.Unmarshal(payload, &received)
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"zeta":"value","alpha":18446744073709551615}`, string(received.Metadata.(json.RawMessage)))
+
+	err = received.VerifySignature(pub)
+	require.NoError(t, err)
+
+	// Tampered metadata fails verification.
+	received.Metadata = json.RawMessage(`{"zeta":"other","alpha":18446744073709551615}`)
+	err = received.VerifySignature(pub)
+	require.Error(t, err)
+}
+
 func newKey(t *testing.T) (crypto.PrivKey, crypto.PubKey) {
 	t.Helper()
 	priv, pub, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
@@ -0,0 +1,19 @@
+package execute
+
+// State describes the stage an execution request is in.
+type State string
+
+// Execution states.
+const (
+	StateQueued         State = "queued"
+	StateRollCalling    State = "roll-calling"
+	StateClusterForming State = "cluster-forming"
+	StateExecuting      State = "executing"
+	StateDone           State = "done"
+	StateFailed         State = "failed"
+	StateCanceled       State = "canceled"
+)
+
+func (s State) String() string {
+	return string(s)
+}
@@ -0,0 +1,247 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Workflow describes a directed acyclic graph of function executions. Outputs of steps can be used as inputs for later steps.
+type Workflow struct {
+	Steps []WorkflowStep `json:"steps"`
+}
+
+// WorkflowStep describes a single function execution within a workflow.
+type WorkflowStep struct {
+	ID         string      `json:"id"`
+	FunctionID string      `json:"function_id"`
+	Method     string      `json:"method"`
+	Parameters []Parameter `json:"parameters,omitempty"`
+	Config     Config      `json:"config"`
+
+	// Steps that should complete before this one is started. Steps referenced by inputs and conditions are implicit dependencies.
+	DependsOn []string `json:"depends_on,omitempty"`
+
+	// Outputs of earlier steps used as inputs for this step.
+	Inputs []StepInput `json:"inputs,omitempty"`
+
+	// Condition that should be met for the step to run. If not met, the step is skipped.
+	When *StepCondition `json:"when,omitempty"`
+}
+
+// StepInputTarget describes how the output of a step is passed on to a later step.
+type StepInputTarget string
+
+const (
+	InputStdin     StepInputTarget = "stdin"     // Output is used as standard input.
+	InputParameter StepInputTarget = "parameter" // Output is appended to the parameters.
+)
+
+// StepInput uses the aggregated standard output of an earlier step as input.
+type StepInput struct {
+	Step string          `json:"step"`
+	As   StepInputTarget `json:"as"`
+}
+
+// ConditionOperator describes how the exit code of a step is compared with the expected one.
+type ConditionOperator string
+
+const (
+	ConditionEqual    ConditionOperator = "eq"
+	ConditionNotEqual ConditionOperator = "ne"
+)
+
+// StepCondition branches on the exit code of an earlier step.
+type StepCondition struct {
+	Step     string            `json:"step"`
+	Operator ConditionOperator `json:"operator,omitempty"` // Defaults to equality.
+	ExitCode int               `json:"exit_code"`
+}
+
+// Met returns true if the exit code satisfies the condition.
+func (c StepCondition) Met(exitCode int) bool {
+
+	if c.Operator == ConditionNotEqual {
+		return exitCode != c.ExitCode
+	}
+
+	return exitCode == c.ExitCode
+}
+
+// Dependencies returns the IDs of all steps that should complete before this one is started.
+func (s WorkflowStep) Dependencies() []string {
+
+	deps := slices.Clone(s.DependsOn)
+	for _, input := range s.Inputs {
+		deps = append(deps, input.Step)
+	}
+
+	if s.When != nil {
+		deps = append(deps, s.When.Step)
+	}
+
+	slices.Sort(deps)
+	return slices.Compact(deps)
+}
+
+// Request returns the execution request for the step, with outputs of earlier steps used as inputs.
+func (s WorkflowStep) Request(outputs map[string]string) Request {
+
+	req := Request{
+		FunctionID: s.FunctionID,
+		Method:     s.Method,
+		Parameters: slices.Clone(s.Parameters),
+		Config:     s.Config,
+	}
+
+	for _, input := range s.Inputs {
+
+		output := outputs[input.Step]
+
+		switch input.As {
+		case InputStdin:
+			req.Config.Stdin = &output
+		case InputParameter:
+			req.Parameters = append(req.Parameters, Parameter{Value: output})
+		}
+	}
+
+	return req
+}
+
+func (w Workflow) Valid() error {
+
+	if len(w.Steps) == 0 {
+		return errors.New("at least one step is required")
+	}
+
+	var err *multierror.Error
+
+	steps := make(map[string]WorkflowStep, len(w.Steps))
+	for _, step := range w.Steps {
+
+		if step.ID == "" {
+			err = multierror.Append(err, errors.New("step ID is required"))
+			continue
+		}
+
+		_, ok := steps[step.ID]
+		if ok {
+			err = multierror.Append(err, fmt.Errorf("duplicate step ID (%s)", step.ID))
+			continue
+		}
+
+		steps[step.ID] = step
+	}
+
+	for _, step := range w.Steps {
+
+		if step.FunctionID == "" {
+			err = multierror.Append(err, fmt.Errorf("function ID is required (step: %s)", step.ID))
+		}
+
+		if step.Method == "" {
+			err = multierror.Append(err, fmt.Errorf("method is required (step: %s)", step.ID))
+		}
+
+		if !step.Config.SelectionStrategy.Valid() {
+			err = multierror.Append(err, fmt.Errorf("unknown selection strategy (step: %s, strategy: %s)", step.ID, step.Config.SelectionStrategy))
+		}
+
+		aerr := step.Config.ResultAggregation.Valid()
+		if aerr != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid result aggregation (step: %s): %w", step.ID, aerr))
+		}
+
+		if step.Config.Attributes != nil {
+			aerr := step.Config.Attributes.Valid()
+			if aerr != nil {
+				err = multierror.Append(err, fmt.Errorf("invalid attributes (step: %s): %w", step.ID, aerr))
+			}
+		}
+
+		for _, input := range step.Inputs {
+			if input.As != InputStdin && input.As != InputParameter {
+				err = multierror.Append(err, fmt.Errorf("unknown input target (step: %s, target: %s)", step.ID, input.As))
+			}
+		}
+
+		if step.When != nil && step.When.Operator != "" &&
+			step.When.Operator != ConditionEqual && step.When.Operator != ConditionNotEqual {
+			err = multierror.Append(err, fmt.Errorf("unknown condition operator (step: %s, operator: %s)", step.ID, step.When.Operator))
+		}
+
+		for _, dep := range step.Dependencies() {
+			_, ok := steps[dep]
+			if !ok {
+				err = multierror.Append(err, fmt.Errorf("unknown step referenced (step: %s, reference: %s)", step.ID, dep))
+			}
+		}
+	}
+
+	if err.ErrorOrNil() != nil {
+		return err
+	}
+
+	if w.hasCycle() {
+		return errors.New("workflow steps form a cycle")
+	}
+
+	return nil
+}
+
+// hasCycle checks if the step dependencies form a cycle, by repeatedly removing steps without dependencies.
+func (w Workflow) hasCycle() bool {
+
+	pending := make(map[string]int, len(w.Steps))
+	dependents := make(map[string][]string)
+	for _, step := range w.Steps {
+		deps := step.Dependencies()
+		pending[step.ID] = len(deps)
+		for _, dep := range deps {
+			dependents[dep] = append(dependents[dep], step.ID)
+		}
+	}
+
+	var ready []string
+	for id, n := range pending {
+		if n == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	var resolved int
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		resolved++
+
+		for _, dependent := range dependents[id] {
+			pending[dependent]--
+			if pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	return resolved != len(w.Steps)
+}
+
+// StepState describes the stage a workflow step is in.
+type StepState string
+
+// Workflow step states.
+const (
+	StepPending   StepState = "pending"
+	StepRunning   StepState = "running"
+	StepSucceeded StepState = "succeeded"
+	StepFailed    StepState = "failed"  // Execution did not produce a result.
+	StepSkipped   StepState = "skipped" // Step condition was not met, or one of the dependencies did not succeed.
+)
+
+// Finished returns true if the step will not change its state any more.
+func (s StepState) Finished() bool {
+	return s == StepSucceeded || s == StepFailed || s == StepSkipped
+}
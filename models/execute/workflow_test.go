@@ -0,0 +1,94 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflow_Valid(t *testing.T) {
+
+	step := func(id string, deps ...string) WorkflowStep {
+		return WorkflowStep{
+			ID:         id,
+			FunctionID: "function-id",
+			Method:     "method",
+			DependsOn:  deps,
+		}
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		second := step("second")
+		second.Inputs = []StepInput{{Step: "first", As: InputStdin}}
+
+		third := step("third", "second")
+		third.When = &StepCondition{Step: "first", Operator: ConditionNotEqual, ExitCode: 1}
+
+		workflow := Workflow{
+			Steps: []WorkflowStep{step("first"), second, third},
+		}
+
+		require.NoError(t, workflow.Valid())
+	})
+	t.Run("no steps", func(t *testing.T) {
+		require.Error(t, Workflow{}.Valid())
+	})
+	t.Run("duplicate step ID", func(t *testing.T) {
+		workflow := Workflow{
+			Steps: []WorkflowStep{step("first"), step("first")},
+		}
+		require.Error(t, workflow.Valid())
+	})
+	t.Run("unknown step referenced", func(t *testing.T) {
+		workflow := Workflow{
+			Steps: []WorkflowStep{step("first", "missing")},
+		}
+		require.Error(t, workflow.Valid())
+	})
+	t.Run("unknown input target", func(t *testing.T) {
+
+		second := step("second")
+		second.Inputs = []StepInput{{Step: "first", As: "env"}}
+
+		workflow := Workflow{
+			Steps: []WorkflowStep{step("first"), second},
+		}
+		require.Error(t, workflow.Valid())
+	})
+	t.Run("cycle", func(t *testing.T) {
+		workflow := Workflow{
+			Steps: []WorkflowStep{step("first", "third"), step("second", "first"), step("third", "second")},
+		}
+		require.Error(t, workflow.Valid())
+	})
+}
+
+func TestWorkflowStep_Request(t *testing.T) {
+
+	step := WorkflowStep{
+		ID:         "step",
+		FunctionID: "function-id",
+		Method:     "method",
+		Parameters: []Parameter{{Value: "--verbose"}},
+		Inputs: []StepInput{
+			{Step: "first", As: InputStdin},
+			{Step: "second", As: InputParameter},
+		},
+	}
+
+	outputs := map[string]string{
+		"first":  "first-output",
+		"second": "second-output",
+	}
+
+	req := step.Request(outputs)
+	require.Equal(t, "function-id", req.FunctionID)
+	require.Equal(t, "method", req.Method)
+	require.NotNil(t, req.Config.Stdin)
+	require.Equal(t, "first-output", *req.Config.Stdin)
+	require.Equal(t, []Parameter{{Value: "--verbose"}, {Value: "second-output"}}, req.Parameters)
+
+	// Step definition is not modified.
+	require.Len(t, step.Parameters, 1)
+}
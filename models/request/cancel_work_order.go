@@ -0,0 +1,30 @@
+package request
+
+import (
+	"encoding/json"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+var _ (json.Marshaler) = (*CancelWorkOrder)(nil)
+
+// CancelWorkOrder describes the `MessageCancelWorkOrder` request payload.
+// It is sent by the head node to abort execution of a work order.
+type CancelWorkOrder struct {
+	blockless.BaseMessage
+	RequestID string `json:"request_id,omitempty"`
+}
+
+func (CancelWorkOrder) Type() string { return blockless.MessageCancelWorkOrder }
+
+func (c CancelWorkOrder) MarshalJSON() ([]byte, error) {
+	type Alias CancelWorkOrder
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(c),
+		Type:  c.Type(),
+	}
+	return json.Marshal(rec)
+}
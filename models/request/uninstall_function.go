@@ -0,0 +1,50 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/response"
+)
+
+var _ (json.Marshaler) = (*UninstallFunction)(nil)
+
+// UninstallFunction describes the `MessageUninstallFunction` request payload.
+type UninstallFunction struct {
+	blockless.BaseMessage
+	CID string `json:"cid,omitempty"`
+}
+
+func (f UninstallFunction) Response(c codes.Code) *response.UninstallFunction {
+	return &response.UninstallFunction{
+		BaseMessage: blockless.BaseMessage{TraceInfo: f.TraceInfo},
+		Code:        c,
+		Message:     "uninstalled",
+		CID:         f.CID,
+	}
+}
+
+func (UninstallFunction) Type() string { return blockless.MessageUninstallFunction }
+
+func (f UninstallFunction) MarshalJSON() ([]byte, error) {
+	type Alias UninstallFunction
+	rec := struct {
+		Alias
+		Type string `json:"type"`
+	}{
+		Alias: Alias(f),
+		Type:  f.Type(),
+	}
+	return json.Marshal(rec)
+}
+
+func (f UninstallFunction) Valid() error {
+
+	if f.CID == "" {
+		return errors.New("function CID is required")
+	}
+
+	return nil
+}
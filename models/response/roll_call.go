@@ -5,6 +5,7 @@ import (
 
 	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
 )
 
 var _ (json.Marshaler) = (*RollCall)(nil)
@@ -15,6 +16,14 @@ type RollCall struct {
 	Code       codes.Code `json:"code,omitempty"`
 	FunctionID string     `json:"function_id,omitempty"`
 	RequestID  string     `json:"request_id,omitempty"`
+
+	// Capacity describes how busy the worker is.
+	Capacity *execute.NodeCapacity `json:"capacity,omitempty"`
+}
+
+func (r *RollCall) WithCapacity(c execute.NodeCapacity) *RollCall {
+	r.Capacity = &c
+	return r
 }
 
 func (RollCall) Type() string { return blockless.MessageRollCallResponse }
@@ -8,7 +8,14 @@ import (
 	"github.com/blocklessnetwork/b7s/models/execute"
 )
 
+// Aggregate groups results with identical output.
 func Aggregate(results execute.ResultMap) Results {
+	return group(results, execute.ResultNormalization{})
+}
+
+// group groups results with equivalent output, as determined by the normalization options.
+// Output of the first peer in the group is used as the group result.
+func group(results execute.ResultMap, normalization execute.ResultNormalization) Results {
 
 	total := len(results)
 	if total == 0 {
@@ -16,24 +23,33 @@ func Aggregate(results execute.ResultMap) Results {
 	}
 
 	type resultStats struct {
+		output   execute.RuntimeOutput
 		seen     uint
 		peers    []peer.ID
 		metadata map[peer.ID]any
 	}
 
-	stats := make(map[execute.RuntimeOutput]resultStats)
-	for executingPeer, res := range results {
+	var (
+		stats = make(map[outputKey]*resultStats)
+		order []outputKey
+	)
 
-		// NOTE: It might make sense to ignore stderr in comparison.
-		output := res.Result.Result
+	normalize := newNormalizer(normalization)
+	for _, executingPeer := range sortedPeers(results) {
 
-		stat, ok := stats[output]
+		res := results[executingPeer]
+		key := normalize(res.Result.Result)
+
+		stat, ok := stats[key]
 		if !ok {
-			stat = resultStats{
+			stat = &resultStats{
+				output:   res.Result.Result,
 				seen:     0,
 				peers:    make([]peer.ID, 0),
 				metadata: make(map[peer.ID]any),
 			}
+			stats[key] = stat
+			order = append(order, key)
 		}
 
 		stat.seen++
@@ -41,16 +57,15 @@ func Aggregate(results execute.ResultMap) Results {
 		if res.Metadata != nil {
 			stat.metadata[executingPeer] = res.Metadata
 		}
-
-		stats[output] = stat
 	}
 
 	// Convert map of results to a slice.
 	aggregated := make([]Result, 0, len(stats))
-	for res, stat := range stats {
+	for _, key := range order {
 
+		stat := stats[key]
 		aggr := Result{
-			Result:    res,
+			Result:    stat.output,
 			Peers:     stat.peers,
 			Frequency: 100 * float64(stat.seen) / float64(total),
 			Metadata:  stat.metadata,
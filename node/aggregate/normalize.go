@@ -0,0 +1,112 @@
+package aggregate
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// outputKey is the normalized execution output, used to determine if outputs are equivalent.
+type outputKey struct {
+	stdout   string
+	stderr   string
+	exitCode int
+
+	// Set if stdout could not be normalized - e.g. it's not JSON, or it lacks the selected field.
+	// Such outputs are only equivalent to identical outputs.
+	raw bool
+}
+
+// newNormalizer returns a function normalizing execution outputs according to the options.
+func newNormalizer(opts execute.ResultNormalization) func(execute.RuntimeOutput) outputKey {
+
+	// Path was validated with the request, an invalid one means outputs cannot be normalized.
+	var (
+		extract fieldExtractor
+		pathErr error
+	)
+	if opts.Field != "" {
+		extract, pathErr = newFieldExtractor(opts.Field)
+	}
+
+	return func(output execute.RuntimeOutput) outputKey {
+
+		key := outputKey{
+			stdout:   output.Stdout,
+			stderr:   output.Stderr,
+			exitCode: output.ExitCode,
+		}
+
+		if opts.IgnoreStderr {
+			key.stderr = ""
+		}
+
+		if opts.IgnoreExitCode {
+			key.exitCode = 0
+		}
+
+		if opts.TrimWhitespace {
+			key.stdout = strings.TrimSpace(key.stdout)
+			key.stderr = strings.TrimSpace(key.stderr)
+		}
+
+		if !opts.CanonicalJSON && opts.Field == "" {
+			return key
+		}
+
+		var doc any
+		err := json.Unmarshal([]byte(key.stdout), &doc)
+		if err != nil || pathErr != nil {
+			key.raw = true
+			return key
+		}
+
+		if opts.Field != "" {
+			value, ok := extract(doc)
+			if !ok {
+				key.raw = true
+				return key
+			}
+
+			doc = value
+		}
+
+		// Encoding is canonical - object keys are sorted.
+		canonical, err := json.Marshal(doc)
+		if err != nil {
+			key.raw = true
+			return key
+		}
+
+		key.stdout = string(canonical)
+
+		return key
+	}
+}
+
+// fieldExtractor returns the value selected from the decoded JSON document, if present.
+type fieldExtractor func(doc any) (any, bool)
+
+// newFieldExtractor returns a function selecting the value of the field from decoded JSON documents. Field is a JSONPath expression.
+func newFieldExtractor(field string) (fieldExtractor, error) {
+
+	path, err := jsonpath.New(field)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(doc any) (any, bool) {
+
+		// Evaluation fails if the document lacks the field.
+		value, err := path(context.Background(), doc)
+		if err != nil {
+			return nil, false
+		}
+
+		return value, true
+	}, nil
+}
@@ -0,0 +1,152 @@
+package aggregate
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAggregateWith_Normalization(t *testing.T) {
+
+	var (
+		first  = mocks.GenericPeerIDs[0]
+		second = mocks.GenericPeerIDs[1]
+		third  = mocks.GenericPeerIDs[2]
+	)
+
+	result := func(output execute.RuntimeOutput) execute.NodeResult {
+		return execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: output,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		normalization execute.ResultNormalization
+		outputs       []execute.RuntimeOutput
+		groups        int
+	}{
+		{
+			name: "no normalization",
+			outputs: []execute.RuntimeOutput{
+				{Stdout: "42", Stderr: "12:00:01"},
+				{Stdout: "42", Stderr: "12:00:02"},
+				{Stdout: "42\n", Stderr: "12:00:01"},
+			},
+			groups: 3,
+		},
+		{
+			name:          "ignore stderr",
+			normalization: execute.ResultNormalization{IgnoreStderr: true},
+			outputs: []execute.RuntimeOutput{
+				{Stdout: "42", Stderr: "12:00:01"},
+				{Stdout: "42", Stderr: "12:00:02"},
+				{Stdout: "42\n", Stderr: "12:00:01"},
+			},
+			groups: 2,
+		},
+		{
+			name:          "ignore stderr and trim whitespace",
+			normalization: execute.ResultNormalization{IgnoreStderr: true, TrimWhitespace: true},
+			outputs: []execute.RuntimeOutput{
+				{Stdout: "42", Stderr: "12:00:01"},
+				{Stdout: "42", Stderr: "12:00:02"},
+				{Stdout: "42\n", Stderr: "12:00:01"},
+			},
+			groups: 1,
+		},
+		{
+			name:          "ignore exit code",
+			normalization: execute.ResultNormalization{IgnoreExitCode: true},
+			outputs: []execute.RuntimeOutput{
+				{Stdout: "42", ExitCode: 0},
+				{Stdout: "42", ExitCode: 1},
+				{Stdout: "43", ExitCode: 0},
+			},
+			groups: 2,
+		},
+		{
+			name:          "canonical JSON",
+			normalization: execute.ResultNormalization{CanonicalJSON: true},
+			outputs: []execute.RuntimeOutput{
+				{Stdout: `{"a": 1, "b": 2}`},
+				{Stdout: `{"b":2,"a":1}`},
+				{Stdout: `not json`},
+			},
+			groups: 2,
+		},
+		{
+			name:          "selected field",
+			normalization: execute.ResultNormalization{Field: "$.data.value"},
+			outputs: []execute.RuntimeOutput{
+				{Stdout: `{"data": {"value": 10}, "timestamp": 1}`},
+				{Stdout: `{"data": {"value": 10}, "timestamp": 2}`},
+				{Stdout: `{"data": {}, "timestamp": 2}`},
+			},
+			groups: 2,
+		},
+		{
+			name:          "selected fields of array elements",
+			normalization: execute.ResultNormalization{Field: "$.items[*].price"},
+			outputs: []execute.RuntimeOutput{
+				{Stdout: `{"items": [{"price": 10, "id": 1}, {"price": 11, "id": 2}]}`},
+				{Stdout: `{"items": [{"price": 10, "id": 3}, {"price": 11, "id": 4}]}`},
+				{Stdout: `{"items": [{"price": 11, "id": 1}, {"price": 10, "id": 2}]}`},
+			},
+			groups: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			peers := []peer.ID{first, second, third}
+			results := make(execute.ResultMap)
+			for i, output := range test.outputs {
+				results[peers[i]] = result(output)
+			}
+
+			cfg := execute.ResultAggregation{Normalization: test.normalization}
+
+			aggregated, err := AggregateWith(cfg, results)
+			require.NoError(t, err)
+			require.Len(t, aggregated, test.groups)
+
+			// Frequencies reflect the number of equivalent results.
+			var total float64
+			for _, res := range aggregated {
+				require.Equal(t, 100*float64(len(res.Peers))/float64(len(results)), res.Frequency)
+				total += res.Frequency
+			}
+			require.InDelta(t, 100, total, 0.001)
+		})
+	}
+
+	t.Run("majority with normalization", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result(execute.RuntimeOutput{Stdout: "yes\n", Stderr: "took 10ms"}),
+			second: result(execute.RuntimeOutput{Stdout: "yes", Stderr: "took 12ms"}),
+			third:  result(execute.RuntimeOutput{Stdout: "no", Stderr: "took 11ms"}),
+		}
+
+		cfg := execute.ResultAggregation{
+			Enable:        true,
+			Type:          execute.AggregateMajority,
+			Normalization: execute.ResultNormalization{IgnoreStderr: true, TrimWhitespace: true},
+		}
+
+		aggregated, err := AggregateWith(cfg, results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 1)
+		require.ElementsMatch(t, []peer.ID{first, second}, aggregated[0].Peers)
+	})
+}
@@ -0,0 +1,347 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// Sentinel errors.
+var (
+	ErrNoAgreement    = errors.New("not enough results agree")
+	ErrNoValidResults = errors.New("no valid results")
+)
+
+// Option can be used to set aggregation parameters that are not part of the aggregation config.
+type Option func(*options)
+
+type options struct {
+	weight func(peer.ID) float64
+}
+
+// WithWeights sets the function returning the weight of a peer's result for the weighted majority vote.
+// By default, all results have the same weight.
+func WithWeights(weight func(peer.ID) float64) Option {
+	return func(opts *options) {
+		opts.weight = weight
+	}
+}
+
+// AggregateWith combines the results using the strategy from the aggregation config.
+func AggregateWith(cfg execute.ResultAggregation, results execute.ResultMap, opts ...Option) (Results, error) {
+
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	options := options{
+		weight: func(peer.ID) float64 { return 1 },
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	switch cfg.Strategy() {
+	case execute.AggregateExact:
+		return group(results, cfg.Normalization), nil
+
+	case execute.AggregateMajority:
+		return majority(cfg, results)
+
+	case execute.AggregateMedian:
+		return numeric(results, median)
+
+	case execute.AggregateMean:
+		return numeric(results, mean)
+
+	case execute.AggregateJSONField:
+		field, _ := cfg.Parameter(execute.AggregationField)
+		return jsonField(field, results)
+
+	case execute.AggregateFastest:
+		return fastest(results)
+
+	case execute.AggregateWeightedMajority:
+		return weightedMajority(cfg, results, options.weight)
+
+	default:
+		return nil, fmt.Errorf("unknown aggregation type (%s)", cfg.Type)
+	}
+}
+
+// majority returns the most frequent result, if enough results agree on it.
+func majority(cfg execute.ResultAggregation, results execute.ResultMap) (Results, error) {
+
+	ratio, set, err := cfg.Ratio()
+	if err != nil {
+		return nil, err
+	}
+
+	aggregated := group(results, cfg.Normalization)
+	top := aggregated[0]
+
+	agreed := top.Frequency > 50
+	if set {
+		agreed = top.Frequency >= 100*ratio
+	}
+
+	if !agreed {
+		return nil, fmt.Errorf("%w (frequency: %.2f%%)", ErrNoAgreement, top.Frequency)
+	}
+
+	return Results{top}, nil
+}
+
+// weightedMajority returns the result whose peers have the most combined weight, if their share of the total weight is large enough.
+func weightedMajority(cfg execute.ResultAggregation, results execute.ResultMap, weight func(peer.ID) float64) (Results, error) {
+
+	ratio, set, err := cfg.Ratio()
+	if err != nil {
+		return nil, err
+	}
+
+	aggregated := group(results, cfg.Normalization)
+
+	var (
+		total   float64
+		top     Result
+		highest = -1.0
+	)
+	for _, res := range aggregated {
+
+		var sum float64
+		for _, id := range res.Peers {
+			sum += max(weight(id), 0)
+		}
+
+		total += sum
+		if sum > highest {
+			top = res
+			highest = sum
+		}
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("%w: results have no weight", ErrNoAgreement)
+	}
+
+	share := highest / total
+
+	agreed := share > 0.5
+	if set {
+		agreed = share >= ratio
+	}
+
+	if !agreed {
+		return nil, fmt.Errorf("%w (weight: %.2f%%)", ErrNoAgreement, 100*share)
+	}
+
+	return Results{top}, nil
+}
+
+// numeric combines numeric outputs of successful executions into a single result. Non-numeric outputs are ignored.
+func numeric(results execute.ResultMap, combine func([]float64) float64) (Results, error) {
+
+	var (
+		values = make([]float64, 0, len(results))
+		peers  = make([]peer.ID, 0, len(results))
+	)
+
+	for _, id := range sortedPeers(results) {
+
+		res := results[id]
+		if !valid(res) {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(res.Result.Result.Stdout), 64)
+		if err != nil {
+			continue
+		}
+
+		values = append(values, value)
+		peers = append(peers, id)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w: no numeric outputs", ErrNoValidResults)
+	}
+
+	result := Result{
+		Result: execute.RuntimeOutput{
+			Stdout: strconv.FormatFloat(combine(values), 'f', -1, 64),
+		},
+		Peers:     peers,
+		Frequency: 100 * float64(len(values)) / float64(len(results)),
+	}
+
+	return Results{result}, nil
+}
+
+func median(values []float64) float64 {
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+func mean(values []float64) float64 {
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
+
+// jsonField groups results by the value of the field in the JSON output. Outputs that are not JSON or lack the field are ignored.
+// Output of the first peer in the group is used as the group result.
+func jsonField(field string, results execute.ResultMap) (Results, error) {
+
+	extract, err := newFieldExtractor(field)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field: %w", err)
+	}
+
+	type group struct {
+		output execute.RuntimeOutput
+		peers  []peer.ID
+	}
+
+	var (
+		groups = make(map[string]*group)
+		order  []string
+	)
+
+	for _, id := range sortedPeers(results) {
+
+		output := results[id].Result.Result
+
+		var doc any
+		err := json.Unmarshal([]byte(output.Stdout), &doc)
+		if err != nil {
+			continue
+		}
+
+		value, ok := extract(doc)
+		if !ok {
+			continue
+		}
+
+		// Encoding is canonical - object keys are sorted.
+		key, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+
+		g, ok := groups[string(key)]
+		if !ok {
+			g = &group{output: output}
+			groups[string(key)] = g
+			order = append(order, string(key))
+		}
+
+		g.peers = append(g.peers, id)
+	}
+
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("%w: no outputs with the field %s", ErrNoValidResults, field)
+	}
+
+	aggregated := make(Results, 0, len(groups))
+	for _, key := range order {
+		g := groups[key]
+		aggregated = append(aggregated, Result{
+			Result:    g.output,
+			Peers:     g.peers,
+			Frequency: 100 * float64(len(g.peers)) / float64(len(results)),
+			Metadata:  metadata(results, g.peers),
+		})
+	}
+
+	sort.SliceStable(aggregated, func(i, j int) bool {
+		return aggregated[i].Frequency > aggregated[j].Frequency
+	})
+
+	return aggregated, nil
+}
+
+// fastest returns the successful result that took the least time to execute.
+func fastest(results execute.ResultMap) (Results, error) {
+
+	var (
+		selected peer.ID
+		found    bool
+	)
+
+	for _, id := range sortedPeers(results) {
+
+		res := results[id]
+		if !valid(res) {
+			continue
+		}
+
+		if !found || res.Usage.WallClockTime < results[selected].Usage.WallClockTime {
+			selected = id
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, ErrNoValidResults
+	}
+
+	result := Result{
+		Result:    results[selected].Result.Result,
+		Peers:     []peer.ID{selected},
+		Frequency: 100 / float64(len(results)),
+		Metadata:  metadata(results, []peer.ID{selected}),
+	}
+
+	return Results{result}, nil
+}
+
+// valid returns true if the execution was successful.
+func valid(res execute.NodeResult) bool {
+	return res.Code == codes.OK && res.Result.Result.ExitCode == 0
+}
+
+// sortedPeers returns the peers from the result map in a stable order.
+func sortedPeers(results execute.ResultMap) []peer.ID {
+
+	peers := make([]peer.ID, 0, len(results))
+	for id := range results {
+		peers = append(peers, id)
+	}
+
+	slices.Sort(peers)
+	return peers
+}
+
+func metadata(results execute.ResultMap, peers []peer.ID) NodeMetadata {
+
+	md := make(NodeMetadata)
+	for _, id := range peers {
+		if results[id].Metadata != nil {
+			md[id] = results[id].Metadata
+		}
+	}
+
+	return md
+}
@@ -0,0 +1,171 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestAggregateWith(t *testing.T) {
+
+	var (
+		first  = mocks.GenericPeerIDs[0]
+		second = mocks.GenericPeerIDs[1]
+		third  = mocks.GenericPeerIDs[2]
+	)
+
+	result := func(stdout string, exitCode int, duration time.Duration) execute.NodeResult {
+		return execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: execute.RuntimeOutput{Stdout: stdout, ExitCode: exitCode},
+				Usage:  execute.Usage{WallClockTime: duration},
+			},
+		}
+	}
+
+	config := func(typ execute.AggregationType, params ...execute.Parameter) execute.ResultAggregation {
+		return execute.ResultAggregation{
+			Enable:     true,
+			Type:       typ,
+			Parameters: params,
+		}
+	}
+
+	t.Run("aggregation not enabled", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result("1", 0, 0),
+			second: result("2", 0, 0),
+		}
+
+		cfg := config(execute.AggregateMean)
+		cfg.Enable = false
+
+		aggregated, err := AggregateWith(cfg, results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 2)
+	})
+	t.Run("majority", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result("yes", 0, 0),
+			second: result("yes", 0, 0),
+			third:  result("no", 0, 0),
+		}
+
+		aggregated, err := AggregateWith(config(execute.AggregateMajority), results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 1)
+		require.Equal(t, "yes", aggregated[0].Result.Stdout)
+		require.ElementsMatch(t, []peer.ID{first, second}, aggregated[0].Peers)
+
+		_, err = AggregateWith(config(execute.AggregateMajority, execute.Parameter{Name: execute.AggregationRatio, Value: "0.9"}), results)
+		require.ErrorIs(t, err, ErrNoAgreement)
+	})
+	t.Run("weighted majority", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result("yes", 0, 0),
+			second: result("yes", 0, 0),
+			third:  result("no", 0, 0),
+		}
+
+		// Without weights, this is a plain majority vote.
+		aggregated, err := AggregateWith(config(execute.AggregateWeightedMajority), results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 1)
+		require.Equal(t, "yes", aggregated[0].Result.Stdout)
+
+		// Third peer is trusted much more than the other two.
+		weights := WithWeights(func(id peer.ID) float64 {
+			if id == third {
+				return 0.9
+			}
+			return 0.1
+		})
+
+		aggregated, err = AggregateWith(config(execute.AggregateWeightedMajority), results, weights)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 1)
+		require.Equal(t, "no", aggregated[0].Result.Stdout)
+		require.Equal(t, []peer.ID{third}, aggregated[0].Peers)
+
+		_, err = AggregateWith(config(execute.AggregateWeightedMajority, execute.Parameter{Name: execute.AggregationRatio, Value: "0.9"}), results, weights)
+		require.ErrorIs(t, err, ErrNoAgreement)
+	})
+	t.Run("median", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result("1\n", 0, 0),
+			second: result("10", 0, 0),
+			third:  result("4", 0, 0),
+		}
+
+		aggregated, err := AggregateWith(config(execute.AggregateMedian), results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 1)
+		require.Equal(t, "4", aggregated[0].Result.Stdout)
+		require.Equal(t, float64(100), aggregated[0].Frequency)
+	})
+	t.Run("mean ignores invalid results", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result("1", 0, 0),
+			second: result("2", 0, 0),
+			third:  result("not-a-number", 0, 0),
+		}
+
+		aggregated, err := AggregateWith(config(execute.AggregateMean), results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 1)
+		require.Equal(t, "1.5", aggregated[0].Result.Stdout)
+		require.ElementsMatch(t, []peer.ID{first, second}, aggregated[0].Peers)
+
+		_, err = AggregateWith(config(execute.AggregateMean), execute.ResultMap{first: result("abc", 0, 0)})
+		require.ErrorIs(t, err, ErrNoValidResults)
+	})
+	t.Run("json field", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result(`{"price": {"value": 10}, "time": 1}`, 0, 0),
+			second: result(`{"price": {"value": 10}, "time": 2}`, 0, 0),
+			third:  result(`{"price": {"value": 11}, "time": 1}`, 0, 0),
+		}
+
+		aggregated, err := AggregateWith(config(execute.AggregateJSONField, execute.Parameter{Name: execute.AggregationField, Value: "$.price.value"}), results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 2)
+		require.ElementsMatch(t, []peer.ID{first, second}, aggregated[0].Peers)
+		require.Equal(t, []peer.ID{third}, aggregated[1].Peers)
+
+		_, err = AggregateWith(config(execute.AggregateJSONField, execute.Parameter{Name: execute.AggregationField, Value: "$.missing"}), results)
+		require.ErrorIs(t, err, ErrNoValidResults)
+	})
+	t.Run("fastest", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			first:  result("slow", 0, 2*time.Second),
+			second: result("fast", 0, time.Second),
+			third:  result("failed", 1, time.Millisecond),
+		}
+
+		aggregated, err := AggregateWith(config(execute.AggregateFastest), results)
+		require.NoError(t, err)
+		require.Len(t, aggregated, 1)
+		require.Equal(t, "fast", aggregated[0].Result.Stdout)
+		require.Equal(t, []peer.ID{second}, aggregated[0].Peers)
+	})
+	t.Run("no results", func(t *testing.T) {
+
+		aggregated, err := AggregateWith(config(execute.AggregateMedian), nil)
+		require.NoError(t, err)
+		require.Empty(t, aggregated)
+	})
+}
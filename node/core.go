@@ -39,6 +39,7 @@ type Messaging interface {
 
 	JoinTopic(string) error
 	Subscribe(context.Context, string) error
+	Topics() []string
 	Publish(context.Context, blockless.Message) error
 	PublishToTopic(context.Context, string, blockless.Message) error
 }
@@ -0,0 +1,223 @@
+package head
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// admissionQueue limits the number of executions the head node orchestrates at the same time.
+// Executions over the limit wait in a bounded queue, ordered by priority and then by arrival.
+type admissionQueue struct {
+	sync.Mutex
+
+	maxActive uint
+	maxQueued uint
+
+	active  uint
+	waiting ticketHeap
+	seq     uint64
+}
+
+// admissionTicket represents the place of an execution in the admission queue.
+type admissionTicket struct {
+	priority int
+	seq      uint64
+	index    int           // Index in the heap, maintained by the heap interface.
+	admitted chan struct{} // Closed once the execution is allowed to run.
+}
+
+func newAdmissionQueue(maxActive uint, maxQueued uint) *admissionQueue {
+
+	q := admissionQueue{
+		maxActive: maxActive,
+		maxQueued: maxQueued,
+	}
+
+	return &q
+}
+
+// enqueue reserves a place for an execution. Execution is admitted straight away if there's room, otherwise it waits in the queue.
+func (q *admissionQueue) enqueue(priority int) (*admissionTicket, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.seq++
+	ticket := &admissionTicket{
+		priority: priority,
+		seq:      q.seq,
+		admitted: make(chan struct{}),
+	}
+
+	if q.active < q.maxActive {
+		q.active++
+		close(ticket.admitted)
+		return ticket, nil
+	}
+
+	if uint(q.waiting.Len()) >= q.maxQueued {
+		return nil, blockless.ErrExecutionQueueFull
+	}
+
+	heap.Push(&q.waiting, ticket)
+
+	return ticket, nil
+}
+
+// wait blocks until the execution is admitted or the context is done. If the context is done first, the ticket is dropped from the queue.
+func (q *admissionQueue) wait(ctx context.Context, ticket *admissionTicket) error {
+
+	select {
+	case <-ticket.admitted:
+		return nil
+	case <-ctx.Done():
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	// We might have been admitted in the meantime. If so, the caller is expected to release the ticket.
+	select {
+	case <-ticket.admitted:
+		return nil
+	default:
+	}
+
+	heap.Remove(&q.waiting, ticket.index)
+
+	return context.Cause(ctx)
+}
+
+// release frees the place held by an admitted execution, admitting the next one in line.
+func (q *admissionQueue) release() {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.active > 0 {
+		q.active--
+	}
+
+	for q.active < q.maxActive && q.waiting.Len() > 0 {
+		next := heap.Pop(&q.waiting).(*admissionTicket)
+		q.active++
+		close(next.admitted)
+	}
+}
+
+// stats returns the number of running and waiting executions.
+func (q *admissionQueue) stats() (uint, uint) {
+	q.Lock()
+	defer q.Unlock()
+
+	return q.active, uint(q.waiting.Len())
+}
+
+// ticketHeap implements heap.Interface - tickets with higher priority come first, and then the ones that arrived earlier.
+type ticketHeap []*admissionTicket
+
+func (h ticketHeap) Len() int { return len(h) }
+
+func (h ticketHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+
+	return h[i].seq < h[j].seq
+}
+
+func (h ticketHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *ticketHeap) Push(x any) {
+	ticket := x.(*admissionTicket)
+	ticket.index = len(*h)
+	*h = append(*h, ticket)
+}
+
+func (h *ticketHeap) Pop() any {
+	old := *h
+	n := len(old)
+	ticket := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return ticket
+}
+
+// admitExecution reserves a place for the execution in the admission queue.
+func (h *HeadNode) admitExecution(requestID string, req execute.Request) (*admissionTicket, error) {
+
+	ticket, err := h.admission.enqueue(req.Config.Priority)
+	if err != nil {
+		h.Metrics().IncrCounter(executionsRejectedMetric, 1)
+		h.Log().Warn().Str("request", requestID).Err(err).Msg("execution request rejected")
+
+		// Request was never executed so the idempotency key can be used again.
+		if req.IdempotencyKey != "" {
+			h.idempotency.forget(req.IdempotencyKey, requestID)
+		}
+
+		return nil, err
+	}
+
+	h.reportAdmissionStats()
+
+	return ticket, nil
+}
+
+// waitForAdmission waits until the execution is allowed to run, or until the queue timeout.
+func (h *HeadNode) waitForAdmission(ctx context.Context, ticket *admissionTicket) error {
+
+	ctx, cancel := context.WithTimeoutCause(ctx, h.cfg.QueueTimeout, blockless.ErrExecutionQueueTimeout)
+	defer cancel()
+
+	err := h.admission.wait(ctx, ticket)
+	h.reportAdmissionStats()
+
+	return err
+}
+
+// releaseExecution frees the place held by the execution.
+func (h *HeadNode) releaseExecution() {
+	h.admission.release()
+	h.reportAdmissionStats()
+}
+
+func (h *HeadNode) reportAdmissionStats() {
+
+	active, queued := h.admission.stats()
+
+	h.Metrics().SetGauge(executionsActiveMetric, float32(active))
+	h.Metrics().SetGauge(executionQueueDepthMetric, float32(queued))
+}
+
+// executeWhenAdmitted waits for the execution to be admitted and then runs it.
+func (h *HeadNode) executeWhenAdmitted(ctx context.Context, requestID string, req request.Execute, ticket *admissionTicket) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	// Execution is part of a request that was already admitted, e.g. the reduce phase of a map-reduce.
+	if ticket == nil {
+		return h.execute(ctx, requestID, req)
+	}
+
+	h.setExecutionState(requestID, execute.StateQueued)
+
+	err := h.waitForAdmission(ctx, ticket)
+	if err != nil {
+		if executionCanceled(ctx) {
+			return codes.Canceled, nil, execute.Cluster{}, blockless.ErrExecutionCanceled
+		}
+
+		return codes.NotAvailable, nil, execute.Cluster{}, fmt.Errorf("could not start execution (request: %s): %w", requestID, err)
+	}
+	defer h.releaseExecution()
+
+	return h.execute(ctx, requestID, req)
+}
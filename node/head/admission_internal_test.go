@@ -0,0 +1,98 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+func TestAdmissionQueue(t *testing.T) {
+
+	t.Run("executions within limit are admitted straight away", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newAdmissionQueue(2, 1)
+
+		for i := 0; i < 2; i++ {
+			ticket, err := queue.enqueue(0)
+			require.NoError(t, err)
+			require.NoError(t, queue.wait(context.Background(), ticket))
+		}
+
+		active, queued := queue.stats()
+		require.Equal(t, uint(2), active)
+		require.Equal(t, uint(0), queued)
+	})
+	t.Run("executions over queue size are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newAdmissionQueue(1, 1)
+
+		_, err := queue.enqueue(0)
+		require.NoError(t, err)
+		_, err = queue.enqueue(0)
+		require.NoError(t, err)
+
+		_, err = queue.enqueue(0)
+		require.ErrorIs(t, err, blockless.ErrExecutionQueueFull)
+	})
+	t.Run("executions are admitted by priority then arrival", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newAdmissionQueue(1, 3)
+
+		_, err := queue.enqueue(0)
+		require.NoError(t, err)
+
+		low, err := queue.enqueue(0)
+		require.NoError(t, err)
+		first, err := queue.enqueue(10)
+		require.NoError(t, err)
+		second, err := queue.enqueue(10)
+		require.NoError(t, err)
+
+		for _, ticket := range []*admissionTicket{first, second, low} {
+			require.False(t, isAdmitted(ticket))
+			queue.release()
+			require.True(t, isAdmitted(ticket))
+		}
+	})
+	t.Run("waiting execution leaves the queue when context is done", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newAdmissionQueue(1, 1)
+
+		_, err := queue.enqueue(0)
+		require.NoError(t, err)
+
+		ticket, err := queue.enqueue(0)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeoutCause(context.Background(), 10*time.Millisecond, blockless.ErrExecutionQueueTimeout)
+		defer cancel()
+
+		err = queue.wait(ctx, ticket)
+		require.ErrorIs(t, err, blockless.ErrExecutionQueueTimeout)
+
+		_, queued := queue.stats()
+		require.Equal(t, uint(0), queued)
+
+		// Releasing the running execution should not admit the abandoned one.
+		queue.release()
+		active, _ := queue.stats()
+		require.Equal(t, uint(0), active)
+	})
+}
+
+func isAdmitted(ticket *admissionTicket) bool {
+	select {
+	case <-ticket.admitted:
+		return true
+	default:
+		return false
+	}
+}
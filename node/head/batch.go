@@ -0,0 +1,241 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// ExecuteBatch executes the function once for each of the batch items. A single roll call is done for the whole batch,
+// and the items are spread across the chosen workers. Results are returned in the order of the batch items.
+// Batch results are not persisted and batch executions cannot be canceled.
+func (h *HeadNode) ExecuteBatch(ctx context.Context, req execute.BatchRequest, subgroup string) (codes.Code, string, []execute.BatchItemResult, error) {
+
+	requestID := newRequestID()
+
+	// Request shared by all batch items, used for the roll call.
+	base := request.Execute{
+		Request: execute.Request{
+			FunctionID: req.FunctionID,
+			Method:     req.Method,
+			Config:     req.Config,
+		},
+		Topic: subgroup,
+	}
+
+	// Batch takes a single place in the execution queue.
+	ticket, err := h.admitExecution(requestID, base.Request)
+	if err != nil {
+		return codes.TooManyRequests, "", nil, err
+	}
+
+	err = h.waitForAdmission(ctx, ticket)
+	if err != nil {
+		return codes.NotAvailable, requestID, nil, fmt.Errorf("could not start batch execution (request: %s): %w", requestID, err)
+	}
+	defer h.releaseExecution()
+
+	code, results, err := h.executeBatch(ctx, requestID, base, req)
+	if err != nil {
+		h.Log().Error().Str("request", requestID).Err(err).Msg("batch execution failed")
+	}
+
+	return code, requestID, results, err
+}
+
+func (h *HeadNode) executeBatch(ctx context.Context, requestID string, base request.Execute, batch execute.BatchRequest) (codes.Code, []execute.BatchItemResult, error) {
+
+	h.Metrics().IncrCounterWithLabels(batchExecutionsMetric, 1, []metrics.Label{{Name: "function", Value: batch.FunctionID}})
+
+	log := h.Log().With().
+		Str("request", requestID).
+		Str("function", batch.FunctionID).
+		Int("items", len(batch.Items)).
+		Logger()
+
+	log.Info().Msg("processing batch execution request")
+
+	defer h.rollCall.remove(requestID)
+
+	workers, standby, err := h.executeRollCall(ctx, requestID, base, 0)
+	if err != nil {
+		code := codes.Error
+		if errors.Is(err, blockless.ErrRollCallTimeout) {
+			code = codes.Timeout
+		}
+
+		return code, nil, fmt.Errorf("could not roll call peers (request: %s): %w", requestID, err)
+	}
+
+	log.Info().Strs("peers", blockless.PeerIDsToStr(workers)).Msg("spreading batch items across workers")
+
+	results := h.executeBatchItems(ctx, requestID, batch, workers, standby)
+
+	var succeeded int
+	for _, res := range results {
+		if res.Result.Code == codes.OK {
+			succeeded++
+		}
+	}
+
+	log.Info().Int("succeeded", succeeded).Msg("batch execution complete")
+
+	retcode := codes.OK
+	if succeeded == 0 {
+		retcode = codes.NoContent
+	} else if succeeded < len(results) {
+		retcode = codes.PartialContent
+	}
+
+	return retcode, results, nil
+}
+
+// executeBatchItems sends work orders for the batch items to the workers in round-robin fashion and collects the results.
+// Items that fail are handed over to standby peers, for as long as the retry budget allows.
+func (h *HeadNode) executeBatchItems(
+	ctx context.Context,
+	requestID string,
+	batch execute.BatchRequest,
+	workers []peer.ID,
+	standby *standbyPool,
+) []execute.BatchItemResult {
+
+	var (
+		results = make([]execute.BatchItemResult, len(batch.Items))
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+
+		retries = h.cfg.RetryBudget
+	)
+
+	log := h.Log().With().Str("request", requestID).Logger()
+
+	// replacement returns a standby peer that should take over the batch item, if any.
+	replacement := func() (peer.ID, bool) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if retries == 0 {
+			return "", false
+		}
+
+		id, ok := standby.next()
+		if !ok {
+			return "", false
+		}
+
+		retries--
+
+		return id, true
+	}
+
+	wg.Add(len(batch.Items))
+
+	for i := range batch.Items {
+		go func(i int) {
+			defer wg.Done()
+
+			itemID := batchItemID(requestID, i)
+			workOrder := request.Execute{Request: batch.Request(i)}.WorkOrder(itemID)
+
+			result := execute.BatchItemResult{
+				Index: i,
+				Result: execute.NodeResult{
+					Result: execute.Result{Code: codes.NoContent},
+				},
+			}
+
+			id := workers[i%len(workers)]
+			for {
+				res, ok := h.executeBatchItem(ctx, itemID, workOrder, id)
+				if ok {
+					result.Peer = id
+					result.Result = res
+				}
+
+				if ok && res.Code == codes.OK {
+					break
+				}
+
+				// See if a standby peer can take over. If not, we'll go with what we have.
+				next, found := replacement()
+				if ctx.Err() != nil || !found {
+					break
+				}
+
+				log.Warn().
+					Int("item", i).
+					Stringer("peer", id).
+					Stringer("standby_peer", next).
+					Bool("responded", ok).
+					Msg("batch item failed, re-dispatching to standby peer")
+
+				id = next
+			}
+
+			results[i] = result
+		}(i)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// executeBatchItem sends the work order for a single batch item to the peer and waits for the result.
+func (h *HeadNode) executeBatchItem(ctx context.Context, itemID string, workOrder *request.WorkOrder, id peer.ID) (execute.NodeResult, bool) {
+
+	h.peerStats.started([]peer.ID{id})
+	defer h.peerStats.release(id)
+
+	h.reputation.dispatched(itemID, id)
+
+	err := h.Send(ctx, id, workOrder)
+	if err != nil {
+		h.Log().Warn().Err(err).Str("request", itemID).Stringer("peer", id).Msg("could not send work order for batch item")
+		h.recordBatchItemOutcome(ctx, itemID, id, outcomeFailed)
+		return execute.NodeResult{}, false
+	}
+
+	res, ok := h.waitForWorkOrderResult(ctx, itemID, id)
+	if !ok && ctx.Err() != nil {
+		return execute.NodeResult{}, false
+	}
+
+	// Result that failed verification is kept as a failed one, so the item can be re-dispatched.
+	if ok && h.resultUnverified(itemID, id) {
+		h.Log().Warn().Str("request", itemID).Stringer("peer", id).Msg("batch item result failed verification")
+	}
+
+	outcome := outcomeSucceeded
+	if !ok {
+		outcome = outcomeTimedOut
+	} else if res.Code != codes.OK {
+		outcome = outcomeFailed
+	}
+	h.recordBatchItemOutcome(ctx, itemID, id, outcome)
+
+	return res, ok
+}
+
+func (h *HeadNode) recordBatchItemOutcome(ctx context.Context, itemID string, id peer.ID, outcome workOutcome) {
+
+	err := h.reputation.recordOutcome(context.WithoutCancel(ctx), itemID, id, outcome)
+	if err != nil {
+		h.Log().Warn().Err(err).Str("request", itemID).Stringer("peer", id).Msg("could not record peer outcome")
+	}
+}
+
+// batchItemID returns the request ID used for the work order of a single batch item.
+func batchItemID(requestID string, i int) string {
+	return fmt.Sprintf("%s-%d", requestID, i)
+}
@@ -0,0 +1,132 @@
+package head
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ExecuteBatchItems(t *testing.T) {
+
+	const (
+		requestID = "dummy-request-id"
+		itemCount = 5
+	)
+
+	var (
+		first   = mocks.GenericPeerIDs[0]
+		second  = mocks.GenericPeerIDs[1]
+		standby = mocks.GenericPeerIDs[2]
+
+		batch = execute.BatchRequest{
+			FunctionID: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		}
+	)
+
+	for i := 0; i < itemCount; i++ {
+		stdin := string(rune('a' + i))
+		batch.Items = append(batch.Items, execute.BatchItem{Stdin: &stdin})
+	}
+
+	// Create head node where workers echo the standard input of the work order, unless they're failing.
+	setup := func(t *testing.T, failing ...peer.ID) (*HeadNode, map[peer.ID][]string) {
+		t.Helper()
+
+		var (
+			lock sync.Mutex
+			sent = make(map[peer.ID][]string)
+		)
+
+		head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
+		require.NoError(t, err)
+		head.cfg.ExecutionTimeout = 100 * time.Millisecond
+
+		core := mocks.BaselineNodeCore(t)
+		core.SendFunc = func(_ context.Context, to peer.ID, msg blockless.Message) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			lock.Lock()
+			sent[to] = append(sent[to], wo.RequestID)
+			lock.Unlock()
+
+			res := execute.NodeResult{
+				Result: execute.Result{
+					Code:   codes.OK,
+					Result: execute.RuntimeOutput{Stdout: *wo.Config.Stdin},
+				},
+			}
+			for _, id := range failing {
+				if id == to {
+					res = execute.NodeResult{Result: execute.Result{Code: codes.Error}}
+				}
+			}
+
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, to), res)
+			return nil
+		}
+		head.Core = core
+
+		return head, sent
+	}
+
+	t.Run("items are spread across workers", func(t *testing.T) {
+
+		head, sent := setup(t)
+
+		results := head.executeBatchItems(context.Background(), requestID, batch, []peer.ID{first, second}, nil)
+		require.Len(t, results, itemCount)
+
+		for i, res := range results {
+			require.Equal(t, i, res.Index)
+			require.Equal(t, codes.OK, res.Result.Code)
+			require.Equal(t, *batch.Items[i].Stdin, res.Result.Result.Result.Stdout)
+		}
+
+		require.ElementsMatch(t, []string{batchItemID(requestID, 0), batchItemID(requestID, 2), batchItemID(requestID, 4)}, sent[first])
+		require.ElementsMatch(t, []string{batchItemID(requestID, 1), batchItemID(requestID, 3)}, sent[second])
+	})
+	t.Run("failed items are re-dispatched", func(t *testing.T) {
+
+		head, _ := setup(t, second)
+
+		pool := &standbyPool{peers: []peer.ID{standby}}
+
+		results := head.executeBatchItems(context.Background(), requestID, batch, []peer.ID{first, second}, pool)
+		require.Len(t, results, itemCount)
+
+		// Standby peer takes over only one item - it's not available any more for the second one.
+		var recovered, failed int
+		for i, res := range results {
+			if i%2 == 0 {
+				require.Equal(t, first, res.Peer)
+				require.Equal(t, codes.OK, res.Result.Code)
+				continue
+			}
+
+			switch res.Peer {
+			case standby:
+				require.Equal(t, *batch.Items[i].Stdin, res.Result.Result.Result.Stdout)
+				recovered++
+			case second:
+				require.Equal(t, codes.Error, res.Result.Code)
+				failed++
+			}
+		}
+
+		require.Equal(t, 1, recovered)
+		require.Equal(t, 1, failed)
+	})
+}
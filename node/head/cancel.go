@@ -0,0 +1,55 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// CancelExecution aborts the execution request, if it's still running.
+func (h *HeadNode) CancelExecution(ctx context.Context, requestID string) error {
+
+	cancel, ok := h.cancels.Get(requestID)
+	if !ok {
+		return blockless.ErrNotFound
+	}
+
+	h.Log().Info().Str("request", requestID).Msg("canceling execution")
+
+	cancel(blockless.ErrExecutionCanceled)
+
+	return nil
+}
+
+// cancelWorkOrder asks the peers to abort their work on the given request.
+func (h *HeadNode) cancelWorkOrder(requestID string, peers []peer.ID) error {
+
+	msg := request.CancelWorkOrder{
+		RequestID: requestID,
+	}
+
+	// Execution context is canceled at this point so we use a new one.
+	ctx, cancel := context.WithTimeout(context.Background(), cancelWorkOrderSendTimeout)
+	defer cancel()
+
+	err := h.SendToMany(ctx, peers, &msg, true)
+	if err != nil {
+		return fmt.Errorf("could not send work order cancellation (request: %s): %w", requestID, err)
+	}
+
+	h.Log().Info().
+		Str("request", requestID).
+		Strs("peers", blockless.PeerIDsToStr(peers)).
+		Msg("sent work order cancellation")
+
+	return nil
+}
+
+func executionCanceled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), blockless.ErrExecutionCanceled)
+}
@@ -0,0 +1,32 @@
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+func TestHead_CancelExecution(t *testing.T) {
+
+	head := createHeadNode(t)
+
+	t.Run("unknown request", func(t *testing.T) {
+		err := head.CancelExecution(context.Background(), "unknown")
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+	t.Run("running execution is canceled", func(t *testing.T) {
+		const requestID = "running"
+
+		ctx, cancel := context.WithCancelCause(context.Background())
+		head.cancels.Set(requestID, cancel)
+
+		err := head.CancelExecution(context.Background(), requestID)
+		require.NoError(t, err)
+
+		require.Error(t, ctx.Err())
+		require.True(t, executionCanceled(ctx))
+	})
+}
@@ -1,9 +1,12 @@
 package head
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/blocklessnetwork/b7s/consensus"
+	"github.com/blocklessnetwork/b7s/models/execute"
 )
 
 // Option can be used to set Node configuration options.
@@ -15,6 +18,14 @@ var DefaultConfig = Config{
 	ExecutionTimeout:        DefaultExecutionTimeout,
 	ClusterFormationTimeout: DefaultClusterFormationTimeout,
 	DefaultConsensus:        DefaultConsensusAlgorithm,
+	ResultRetention:         DefaultResultRetention,
+	RollCallWindow:          DefaultRollCallWindow,
+	DefaultSelection:        DefaultSelectionStrategy,
+	RetryBudget:             DefaultRetryBudget,
+	IdempotencyWindow:       DefaultIdempotencyWindow,
+	MaxConcurrentExecutions: DefaultMaxConcurrentExecutions,
+	QueueSize:               DefaultQueueSize,
+	QueueTimeout:            DefaultQueueTimeout,
 }
 
 // Config represents the Node configuration.
@@ -23,8 +34,98 @@ type Config struct {
 	ExecutionTimeout        time.Duration  // How long does the head node wait for worker nodes to send their execution results.
 	ClusterFormationTimeout time.Duration  // How long do we wait for the nodes to form a cluster for an execution.
 	DefaultConsensus        consensus.Type // Default consensus algorithm to use.
+	ResultRetention         time.Duration  // How long do we keep execution results. Zero means results are kept indefinitely.
+	RollCallWindow          time.Duration  // How long do we collect roll call responses before choosing workers.
+	RetryBudget             uint           // How many times can a failed work order be handed over to a standby peer.
+	IdempotencyWindow       time.Duration  // For how long are repeated submissions with the same idempotency key deduplicated.
+	MaxConcurrentExecutions uint           // How many executions can the node orchestrate at the same time.
+	QueueSize               uint           // How many executions can wait for their turn. Executions over this limit are rejected.
+	QueueTimeout            time.Duration  // How long can an execution wait for its turn.
+
+	DefaultSelection execute.SelectionStrategy // Default strategy for choosing workers among roll called peers.
 }
 
 func (c Config) Valid() error {
+
+	if c.ResultRetention < 0 {
+		return errors.New("result retention cannot be negative")
+	}
+
+	if c.IdempotencyWindow < 0 {
+		return errors.New("idempotency window cannot be negative")
+	}
+
+	if c.MaxConcurrentExecutions == 0 {
+		return errors.New("maximum number of concurrent executions must be positive")
+	}
+
+	if c.QueueTimeout <= 0 {
+		return errors.New("queue timeout must be positive")
+	}
+
+	if c.RollCallWindow < 0 {
+		return errors.New("roll call window cannot be negative")
+	}
+
+	if c.DefaultSelection == "" || !c.DefaultSelection.Valid() {
+		return fmt.Errorf("invalid default selection strategy (%s)", c.DefaultSelection)
+	}
+
 	return nil
 }
+
+// ResultRetention sets how long the execution results are kept by the head node.
+func ResultRetention(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ResultRetention = d
+	}
+}
+
+// RollCallWindow sets how long the head node collects roll call responses before choosing workers.
+func RollCallWindow(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.RollCallWindow = d
+	}
+}
+
+// DefaultSelection sets the strategy used to choose workers when the execution request does not specify one.
+func DefaultSelection(s execute.SelectionStrategy) Option {
+	return func(cfg *Config) {
+		cfg.DefaultSelection = s
+	}
+}
+
+// RetryBudget sets how many times the work orders of an execution can be handed over to standby peers.
+func RetryBudget(n uint) Option {
+	return func(cfg *Config) {
+		cfg.RetryBudget = n
+	}
+}
+
+// IdempotencyWindow sets for how long repeated submissions with the same idempotency key are deduplicated.
+func IdempotencyWindow(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.IdempotencyWindow = d
+	}
+}
+
+// MaxConcurrentExecutions sets how many executions the node can orchestrate at the same time.
+func MaxConcurrentExecutions(n uint) Option {
+	return func(cfg *Config) {
+		cfg.MaxConcurrentExecutions = n
+	}
+}
+
+// QueueSize sets how many executions can wait for their turn in the execution queue.
+func QueueSize(n uint) Option {
+	return func(cfg *Config) {
+		cfg.QueueSize = n
+	}
+}
+
+// QueueTimeout sets how long can an execution wait for its turn in the execution queue.
+func QueueTimeout(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.QueueTimeout = d
+	}
+}
@@ -0,0 +1,95 @@
+package head
+
+import (
+	"sync"
+
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+func (h *HeadNode) publishEvent(event execute.Event) {
+
+	missed := h.events.publish(event)
+	if missed > 0 {
+		h.Log().Warn().
+			Str("request", event.RequestID).
+			Stringer("event", event.Type).
+			Int("subscribers", missed).
+			Msg("execution event dropped for slow subscribers")
+	}
+}
+
+// eventBroker distributes execution events to the subscribers interested in a particular request.
+type eventBroker struct {
+	sync.Mutex
+	subscribers map[string][]chan execute.Event
+}
+
+func newEventBroker() *eventBroker {
+
+	b := eventBroker{
+		subscribers: make(map[string][]chan execute.Event),
+	}
+
+	return &b
+}
+
+// subscribe returns a channel on which events for the given request will be delivered, along with a function
+// to cancel the subscription. The channel is closed once the execution is done or when the subscription is canceled.
+func (b *eventBroker) subscribe(requestID string) (<-chan execute.Event, func()) {
+	b.Lock()
+	defer b.Unlock()
+
+	ch := make(chan execute.Event, executionEventBufferSize)
+	b.subscribers[requestID] = append(b.subscribers[requestID], ch)
+
+	unsubscribe := func() {
+		b.Lock()
+		defer b.Unlock()
+
+		subs := b.subscribers[requestID]
+		for i, sub := range subs {
+			if sub != ch {
+				continue
+			}
+
+			close(ch)
+			b.subscribers[requestID] = append(subs[:i], subs[i+1:]...)
+			if len(b.subscribers[requestID]) == 0 {
+				delete(b.subscribers, requestID)
+			}
+			return
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// publish delivers the event to all subscribers of the request. Slow subscribers that cannot keep up miss events.
+// It returns the number of subscribers that did not receive the event.
+func (b *eventBroker) publish(event execute.Event) int {
+	b.Lock()
+	defer b.Unlock()
+
+	missed := 0
+	for _, ch := range b.subscribers[event.RequestID] {
+		select {
+		case ch <- event:
+		default:
+			missed++
+		}
+	}
+
+	return missed
+}
+
+// close closes all subscriptions for the given request.
+func (b *eventBroker) close(requestID string) {
+	b.Lock()
+	defer b.Unlock()
+
+	for _, ch := range b.subscribers[requestID] {
+		close(ch)
+	}
+
+	delete(b.subscribers, requestID)
+}
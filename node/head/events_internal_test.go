@@ -0,0 +1,72 @@
+package head
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+func TestEventBroker(t *testing.T) {
+
+	const requestID = "dummy-request-id"
+
+	t.Run("events delivered to subscribers", func(t *testing.T) {
+
+		broker := newEventBroker()
+
+		first, _ := broker.subscribe(requestID)
+		second, _ := broker.subscribe(requestID)
+		other, _ := broker.subscribe("other-request")
+
+		event := execute.Event{
+			Type:      execute.EventState,
+			RequestID: requestID,
+			State:     execute.StateExecuting,
+		}
+
+		missed := broker.publish(event)
+		require.Zero(t, missed)
+
+		require.Equal(t, event, <-first)
+		require.Equal(t, event, <-second)
+		require.Empty(t, other)
+
+		broker.close(requestID)
+
+		_, ok := <-first
+		require.False(t, ok)
+		_, ok = <-second
+		require.False(t, ok)
+	})
+	t.Run("unsubscribed channels are closed", func(t *testing.T) {
+
+		broker := newEventBroker()
+
+		events, unsubscribe := broker.subscribe(requestID)
+		unsubscribe()
+
+		_, ok := <-events
+		require.False(t, ok)
+
+		// Publishing and closing after unsubscribing is safe.
+		missed := broker.publish(execute.Event{RequestID: requestID})
+		require.Zero(t, missed)
+		broker.close(requestID)
+		unsubscribe()
+	})
+	t.Run("slow subscribers miss events", func(t *testing.T) {
+
+		broker := newEventBroker()
+
+		_, _ = broker.subscribe(requestID)
+		for i := 0; i < executionEventBufferSize; i++ {
+			missed := broker.publish(execute.Event{RequestID: requestID})
+			require.Zero(t, missed)
+		}
+
+		missed := broker.publish(execute.Event{RequestID: requestID})
+		require.Equal(t, 1, missed)
+	})
+}
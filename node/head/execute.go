@@ -4,17 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/armon/go-metrics"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"go.opentelemetry.io/otel/trace"
 
 	cons "github.com/blocklessnetwork/b7s/consensus"
+	"github.com/blocklessnetwork/b7s/consensus/pbft"
 	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/execute"
 	"github.com/blocklessnetwork/b7s/models/request"
 	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/node/aggregate"
 	"github.com/blocklessnetwork/b7s/telemetry/tracing"
 )
 
@@ -30,18 +33,17 @@ func (h *HeadNode) processExecute(ctx context.Context, from peer.ID, req request
 		return nil
 	}
 
-	requestID := newRequestID()
+	if req.Config.NodeCount == 0 {
+		req.Config.NodeCount = -1
+	}
+
+	requestID, code, results, cluster, err := h.executeOnce(ctx, req)
 
 	log := h.Log().With().
 		Stringer("peer", from).
 		Str("request", requestID).
 		Str("function", req.FunctionID).Logger()
 
-	if req.Config.NodeCount == 0 {
-		req.Config.NodeCount = -1
-	}
-
-	code, results, cluster, err := h.execute(ctx, requestID, req)
 	if err != nil {
 		log.Error().Err(err).Msg("execution failed")
 	}
@@ -50,9 +52,7 @@ func (h *HeadNode) processExecute(ctx context.Context, from peer.ID, req request
 
 	res := req.Response(code, requestID).WithResults(results).WithCluster(cluster)
 	// Communicate the reason for failure in these cases.
-	if errors.Is(err, blockless.ErrRollCallTimeout) || errors.Is(err, blockless.ErrExecutionNotEnoughNodes) {
-		res.ErrorMessage = err.Error()
-	}
+	res.ErrorMessage = executionFailureMessage(err)
 
 	// Send the response, whatever it may be (success or failure).
 	err = h.Send(ctx, from, res)
@@ -103,12 +103,20 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 	log.Info().Msg("processing execution request")
 
 	// Phase 1. - Issue roll call to nodes.
-	reportingPeers, err := h.executeRollCall(ctx, requestID, req, consensus)
+	h.setExecutionState(requestID, execute.StateRollCalling)
+
+	// Roll call is kept open until the execution is done, so peers reporting late can be used as standby.
+	defer h.rollCall.remove(requestID)
+
+	reportingPeers, standby, err := h.executeRollCall(ctx, requestID, req, consensus)
 	if err != nil {
 		code := codes.Error
 		if errors.Is(err, blockless.ErrRollCallTimeout) {
 			code = codes.Timeout
 		}
+		if executionCanceled(ctx) {
+			code = codes.Canceled
+		}
 
 		return code, nil, execute.Cluster{}, fmt.Errorf("could not roll call peers (request: %s): %w", requestID, err)
 	}
@@ -117,12 +125,36 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		Peers: reportingPeers,
 	}
 
+	// Keep track of the work assigned to peers and how it turned out.
+	var results execute.ResultMap
+	h.peerStats.started(reportingPeers)
+	defer func() {
+		h.recordPeerOutcomes(ctx, requestID, req.Config.ResultAggregation, cluster.Peers, results, consensusRequired(consensus) || executionCanceled(ctx))
+	}()
+
+	// If the execution gets canceled from now on, peers should know so they can abort their work.
+	defer func() {
+		if !executionCanceled(ctx) {
+			return
+		}
+
+		err := h.cancelWorkOrder(requestID, cluster.Peers)
+		if err != nil {
+			log.Error().Err(err).Msg("could not send work order cancellation to peers")
+		}
+	}()
+
 	// Phase 2. - Request cluster formation, if we need consensus.
 	if consensusRequired(consensus) {
 
 		log.Info().Strs("peers", blockless.PeerIDsToStr(reportingPeers)).Msg("requesting cluster formation from peers who reported for roll call")
 
+		h.setExecutionState(requestID, execute.StateClusterForming)
+
 		err := h.formCluster(ctx, requestID, reportingPeers, consensus)
+		if executionCanceled(ctx) {
+			return codes.Canceled, nil, execute.Cluster{}, blockless.ErrExecutionCanceled
+		}
 		if err != nil {
 			return codes.Error, nil, execute.Cluster{}, fmt.Errorf("could not form cluster (request: %s): %w", requestID, err)
 		}
@@ -132,10 +164,16 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		// One variant I tried is waiting on the execution to be done on the leader (using a timed wait on the execution response) and starting raft shutdown after.
 		// However, this can happen too fast and the execution request might not have been propagated to all of the nodes in the cluster, but "only" to a majority.
 		// Doing this here allows for more wiggle room and ~probably~ all nodes will have seen the request so far.
-		defer h.disbandCluster(requestID, reportingPeers)
+		// If the execution was canceled, peers tear down the cluster on their own.
+		defer func() {
+			if !executionCanceled(ctx) {
+				h.disbandCluster(requestID, reportingPeers)
+			}
+		}()
 	}
 
 	// Phase 3. - Request execution.
+	h.setExecutionState(requestID, execute.StateExecuting)
 
 	// Send the work order to peers in the cluster. Non-leaders will drop the request.
 	workOrder := req.WorkOrder(requestID)
@@ -148,20 +186,34 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		}
 	}
 
+	h.reputation.dispatched(requestID, reportingPeers...)
 	err = h.SendToMany(ctx,
 		reportingPeers,
 		workOrder,
 		consensusRequired(consensus), // If we're using consensus, try to reach all peers.
 	)
+	if executionCanceled(ctx) {
+		return codes.Canceled, nil, cluster, blockless.ErrExecutionCanceled
+	}
 	if err != nil {
 		return codes.Error, nil, cluster, fmt.Errorf("could not send execution request to peers (function: %s, request: %s): %w", req.FunctionID, requestID, err)
 	}
 
 	log.Debug().Msg("waiting for execution responses")
 
-	var results execute.ResultMap
 	if consensus == cons.PBFT {
 		results = h.gatherExecutionResultsPBFT(ctx, requestID, reportingPeers)
+		cluster.Unverified = h.dropUnverifiedResults(requestID, cluster.Peers, results)
+		if executionCanceled(ctx) {
+			return codes.Canceled, results, cluster, blockless.ErrExecutionCanceled
+		}
+
+		// Without f+1 identical results we cannot vouch for any of them.
+		need := pbft.MinClusterResults(uint(len(reportingPeers)))
+		if uint(len(results)) < need {
+			log.Warn().Uint("need", need).Int("have", len(results)).Msg("not enough matching PBFT execution results")
+			return codes.NoConsensus, results, cluster, fmt.Errorf("could not get matching results (request: %s, need: %d): %w", requestID, need, blockless.ErrNotEnoughMatchingResults)
+		}
 
 		log.Info().Msg("received PBFT execution responses")
 
@@ -175,12 +227,27 @@ func (h *HeadNode) execute(ctx context.Context, requestID string, req request.Ex
 		return retcode, results, cluster, nil
 	}
 
-	results = h.gatherExecutionResults(ctx, requestID, reportingPeers)
+	if consensusRequired(consensus) {
+		results = h.gatherExecutionResults(ctx, requestID, reportingPeers)
+	} else {
+		// Without consensus, work orders of failed peers can be handed over to standby peers.
+		results, cluster.Peers = h.gatherExecutionResultsWithFailover(ctx, requestID, workOrder, reportingPeers, standby)
+	}
+	cluster.Unverified = h.dropUnverifiedResults(requestID, cluster.Peers, results)
+	if len(cluster.Unverified) > 0 {
+		log.Warn().Strs("peers", blockless.PeerIDsToStr(cluster.Unverified)).Msg("dropped execution results that failed verification")
+	}
+	if executionCanceled(ctx) {
+		return codes.Canceled, results, cluster, blockless.ErrExecutionCanceled
+	}
 
-	log.Info().Int("cluster_size", len(reportingPeers)).Int("responded", len(results)).Msg("received execution responses")
+	log.Info().Int("cluster_size", len(cluster.Peers)).Int("responded", len(results)).Msg("received execution responses")
 
-	// How many results do we have, and how many do we expect.
-	respondRatio := float64(len(results)) / float64(len(reportingPeers))
+	// How many results do we have, and how many do we expect. Peers that were replaced by standby peers are not counted.
+	var respondRatio float64
+	if len(reportingPeers) > 0 {
+		respondRatio = float64(len(results)) / float64(len(reportingPeers))
+	}
 	threshold := determineThreshold(req.Request)
 
 	retcode := codes.OK
@@ -202,11 +269,111 @@ func (h *HeadNode) processWorkOrderResponse(ctx context.Context, from peer.ID, r
 		Msg("received work order response")
 
 	key := peerRequestKey(res.RequestID, from)
+
+	// Results we cannot attribute to the sender are dropped.
+	err := verifyResult(from, res.Result)
+	if err != nil {
+		h.Metrics().IncrCounter(unverifiedResultsMetric, 1)
+		h.Log().Warn().
+			Err(err).
+			Stringer("from", from).
+			Str("request", res.RequestID).
+			Msg("dropping execution result that failed verification")
+
+		h.unverifiedResults.Set(key, struct{}{})
+		h.workOrderResponses.Set(key, unverifiedResult())
+		return nil
+	}
+
+	h.reputation.responded(res.RequestID, from)
 	h.workOrderResponses.Set(key, res.Result)
 
+	h.publishEvent(execute.Event{
+		Type:      execute.EventResult,
+		RequestID: res.RequestID,
+		Peer:      from,
+		Result:    res.Result,
+	})
+
 	return nil
 }
 
+// recordPeerOutcomes updates peer stats and reputations based on the execution results. Peers without a result are counted
+// as failed if they responded and as timed out if they did not, unless the missing results are expected, e.g. when consensus
+// is used or the execution was canceled. Peers are also rated on whether their results agree with the majority.
+func (h *HeadNode) recordPeerOutcomes(ctx context.Context, requestID string, aggregation execute.ResultAggregation, peers []peer.ID, results execute.ResultMap, tolerateMissing bool) {
+
+	// Outcomes are recorded even if the execution was canceled.
+	ctx = context.WithoutCancel(ctx)
+
+	for _, id := range peers {
+
+		h.peerStats.release(id)
+
+		res, ok := results[id]
+		if !ok && tolerateMissing {
+			continue
+		}
+
+		outcome := outcomeSucceeded
+		if !ok {
+			outcome = outcomeTimedOut
+			_, responded := h.workOrderResponses.Get(peerRequestKey(requestID, id))
+			if responded {
+				outcome = outcomeFailed
+			}
+		} else if res.Code != codes.OK {
+			outcome = outcomeFailed
+		}
+
+		err := h.reputation.recordOutcome(ctx, requestID, id, outcome)
+		if err != nil {
+			h.Log().Warn().Err(err).Str("request", requestID).Stringer("peer", id).Msg("could not record peer outcome")
+		}
+	}
+
+	agreed, disagreed := majorityAgreement(aggregation, results)
+	err := h.reputation.recordAgreement(ctx, agreed, disagreed)
+	if err != nil {
+		h.Log().Warn().Err(err).Str("request", requestID).Msg("could not record peer agreement")
+	}
+}
+
+// majorityAgreement splits the peers into those whose results agree with the majority and those whose results do not.
+// Results are grouped the same way they are aggregated for the user, and only successful results are considered.
+// If no result is returned by the majority of peers, or there is a single result, there is nothing to agree on.
+func majorityAgreement(aggregation execute.ResultAggregation, results execute.ResultMap) ([]peer.ID, []peer.ID) {
+
+	successful := make(execute.ResultMap, len(results))
+	for id, res := range results {
+		if res.Code == codes.OK {
+			successful[id] = res
+		}
+	}
+
+	if len(successful) < 2 {
+		return nil, nil
+	}
+
+	aggregated, err := aggregate.AggregateWith(aggregation, successful)
+	if err != nil || len(aggregated) == 0 || aggregated[0].Frequency <= 50 {
+		return nil, nil
+	}
+
+	// Some strategies only return the winning result, so everyone else disagreed.
+	agreed := aggregated[0].Peers
+	var disagreed []peer.ID
+	for id := range successful {
+		if !slices.Contains(agreed, id) {
+			disagreed = append(disagreed, id)
+		}
+	}
+
+	slices.Sort(disagreed)
+
+	return agreed, disagreed
+}
+
 func determineThreshold(req execute.Request) float64 {
 
 	if req.Config.Threshold > 0 && req.Config.Threshold <= 1 {
@@ -139,7 +139,7 @@ func TestHead_Execute(t *testing.T) {
 func createHeadNode(t *testing.T) *HeadNode {
 	t.Helper()
 
-	head, err := New(mocks.BaselineNodeCore(t))
+	head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
 	require.NoError(t, err)
 
 	return head
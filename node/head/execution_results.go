@@ -2,42 +2,39 @@ package head
 
 import (
 	"context"
-	"fmt"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blocklessnetwork/b7s/consensus/pbft"
+	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
 )
 
 // gatherExecutionResultsPBFT collects execution results from a PBFT cluster. This means f+1 identical results.
+// Results are grouped by their digest and only results signed by the replica that sent them are counted.
+// If there are not enough matching results from distinct replicas, the returned map is empty.
 func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID string, peers []peer.ID) execute.ResultMap {
 
 	exctx, exCancel := context.WithTimeout(ctx, h.cfg.ExecutionTimeout)
 	defer exCancel()
 
-	type aggregatedResult struct {
-		result   execute.Result
-		peers    []peer.ID
-		metadata map[peer.ID]any
-	}
-
 	var (
 		count = pbft.MinClusterResults(uint(len(peers)))
 		lock  sync.Mutex
 		wg    sync.WaitGroup
 
-		results                   = make(map[string]aggregatedResult)
-		out     execute.ResultMap = make(map[peer.ID]execute.NodeResult)
+		// Results grouped by digest. Each replica is represented at most once, since we wait for a single result per peer.
+		results = make(map[string]execute.ResultMap)
+		quorum  string
 	)
 
-	// We use a map as a simple way to count identical results.
-	// Equality means same result (process outputs) and same request timestamp.
-	peerResultMapKey := func(res execute.NodeResult) string {
-		return fmt.Sprintf("%+#v-%s", res.Result.Result, res.PBFT.RequestTimestamp.String())
-	}
-
 	wg.Add(len(peers))
 
 	for _, rp := range peers {
@@ -52,59 +49,65 @@ func (h *HeadNode) gatherExecutionResultsPBFT(ctx context.Context, requestID str
 
 			h.Log().Info().Stringer("peer", sender).Str("request", requestID).Msg("accounted execution response from peer")
 
-			pub, err := sender.ExtractPublicKey()
+			err := verifyResult(sender, res)
 			if err != nil {
-				h.Log().Error().Err(err).Msg("could not derive public key from peer ID")
+				h.Log().Error().Err(err).Stringer("peer", sender).Msg("could not verify signature of an execution response")
 				return
 			}
 
-			err = res.VerifySignature(pub)
-			if err != nil {
-				h.Log().Error().Err(err).Msg("could not verify signature of an execution response")
+			// Replicas sign their own results - we don't accept results relayed on behalf of other replicas.
+			if res.PBFT.Replica != sender {
+				h.Log().Error().Stringer("peer", sender).Stringer("replica", res.PBFT.Replica).Msg("execution response not produced by the replica that sent it")
 				return
 			}
 
 			lock.Lock()
 			defer lock.Unlock()
 
-			reskey := peerResultMapKey(res)
-			result, ok := results[reskey]
+			digest := pbftResultDigest(res)
+			group, ok := results[digest]
 			if !ok {
-				results[reskey] = aggregatedResult{
-					result: res.Result,
-					peers: []peer.ID{
-						sender,
-					},
-					metadata: map[peer.ID]any{
-						sender: res.Metadata,
-					},
-				}
-				return
+				group = make(execute.ResultMap)
+				results[digest] = group
 			}
+			group[sender] = res
 
-			// Record which peers have this result, and their metadata.
-			result.peers = append(result.peers, sender)
-			result.metadata[sender] = res.Metadata
-
-			results[reskey] = result
-
-			if uint(len(result.peers)) >= count {
+			if quorum == "" && uint(len(group)) >= count {
 				h.Log().Info().Str("request", requestID).Int("peers", len(peers)).Uint("matching_results", count).Msg("have enough matching results")
-				exCancel()
 
-				for _, peer := range result.peers {
-					out[peer] = execute.NodeResult{
-						Result:   result.result,
-						Metadata: result.metadata[peer],
-					}
-				}
+				quorum = digest
+				exCancel()
 			}
 		}(rp)
 	}
 
 	wg.Wait()
 
-	return out
+	if quorum == "" {
+		return make(execute.ResultMap)
+	}
+
+	return results[quorum]
+}
+
+// pbftResultDigest returns the digest of the parts of the result that must be identical across replicas.
+// Equality means same result (process outputs) and same request timestamp.
+func pbftResultDigest(res execute.NodeResult) string {
+
+	rec := struct {
+		Code             codes.Code            `json:"code"`
+		Result           execute.RuntimeOutput `json:"result"`
+		RequestTimestamp time.Time             `json:"request_timestamp"`
+	}{
+		Code:             res.Code,
+		Result:           res.Result.Result,
+		RequestTimestamp: res.PBFT.RequestTimestamp,
+	}
+
+	payload, _ := json.Marshal(rec)
+	hash := sha256.Sum256(payload)
+
+	return hex.EncodeToString(hash[:])
 }
 
 // gatherExecutionResults collects execution results from direct executions or raft clusters.
@@ -146,3 +149,108 @@ func (h *HeadNode) gatherExecutionResults(ctx context.Context, requestID string,
 
 	return results
 }
+
+// gatherExecutionResultsWithFailover collects execution results from direct executions. If a peer does not respond in time,
+// or reports a failed execution, the work order is sent to a standby peer, for as long as the retry budget allows.
+// Returned are the results and the list of all peers that received the work order.
+func (h *HeadNode) gatherExecutionResultsWithFailover(
+	ctx context.Context,
+	requestID string,
+	workOrder *request.WorkOrder,
+	peers []peer.ID,
+	standby *standbyPool,
+) (execute.ResultMap, []peer.ID) {
+
+	var (
+		results execute.ResultMap = make(map[peer.ID]execute.NodeResult)
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+
+		dispatched = slices.Clone(peers)
+		retries    = h.cfg.RetryBudget
+	)
+
+	log := h.Log().With().Str("request", requestID).Logger()
+
+	// replacement returns a standby peer that should take over the work order, if any.
+	replacement := func() (peer.ID, bool) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if retries == 0 {
+			return "", false
+		}
+
+		id, ok := standby.next()
+		if !ok {
+			return "", false
+		}
+
+		retries--
+		dispatched = append(dispatched, id)
+
+		return id, true
+	}
+
+	wg.Add(len(peers))
+
+	// Wait on peers asynchronously.
+	for _, rp := range peers {
+		go func(id peer.ID) {
+			defer wg.Done()
+
+			for {
+				res, ok := h.waitForWorkOrderResult(ctx, requestID, id)
+				if ok && res.Code == codes.OK {
+					log.Info().Stringer("peer", id).Msg("accounted execution response from peer")
+
+					lock.Lock()
+					results[id] = res
+					lock.Unlock()
+					return
+				}
+
+				// See if a standby peer can take over. If not, we'll go with what we have.
+				next, found := replacement()
+				if ctx.Err() != nil || !found {
+					if ok {
+						lock.Lock()
+						results[id] = res
+						lock.Unlock()
+					}
+					return
+				}
+
+				log.Warn().
+					Stringer("peer", id).
+					Stringer("standby_peer", next).
+					Bool("responded", ok).
+					Msg("work order failed, re-dispatching to standby peer")
+
+				h.peerStats.started([]peer.ID{next})
+				h.reputation.dispatched(requestID, next)
+
+				err := h.Send(ctx, next, workOrder)
+				if err != nil {
+					log.Warn().Err(err).Stringer("peer", next).Msg("could not send work order to standby peer")
+				}
+
+				id = next
+			}
+		}(rp)
+	}
+
+	wg.Wait()
+
+	return results, dispatched
+}
+
+// waitForWorkOrderResult waits for the peer to send the result for the work order.
+func (h *HeadNode) waitForWorkOrderResult(ctx context.Context, requestID string, id peer.ID) (execute.NodeResult, bool) {
+
+	// We're willing to wait for a limited amount of time.
+	exctx, exCancel := context.WithTimeout(ctx, h.cfg.ExecutionTimeout)
+	defer exCancel()
+
+	return h.workOrderResponses.WaitFor(exctx, peerRequestKey(requestID, id))
+}
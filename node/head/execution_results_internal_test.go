@@ -0,0 +1,249 @@
+package head
+
+import (
+	"context"
+	"crypto/rand"
+	mrand "math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/consensus/pbft"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_GatherExecutionResultsWithFailover(t *testing.T) {
+
+	const (
+		requestID = "dummy-request-id"
+	)
+
+	var (
+		workOrder = request.WorkOrder{
+			RequestID: requestID,
+			Request:   mocks.GenericExecutionRequest,
+		}
+
+		ok = execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: mocks.GenericExecutionResult.Result,
+			},
+		}
+		failed = execute.NodeResult{
+			Result: execute.Result{Code: codes.Error},
+		}
+
+		healthy   = mocks.GenericPeerIDs[0]
+		failing   = mocks.GenericPeerIDs[1]
+		silent    = mocks.GenericPeerIDs[2]
+		standbyOK = mocks.GenericPeerIDs[3]
+		standbyKO = mocks.GenericPeerIDs[4]
+	)
+
+	// Create head node where standby peers respond to work orders with the given results.
+	setup := func(t *testing.T, budget uint, standbyResults map[peer.ID]execute.NodeResult) (*HeadNode, *[]peer.ID) {
+		t.Helper()
+
+		var (
+			lock sync.Mutex
+			sent []peer.ID
+		)
+
+		head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t), RetryBudget(budget))
+		require.NoError(t, err)
+		head.cfg.ExecutionTimeout = 100 * time.Millisecond
+
+		core := mocks.BaselineNodeCore(t)
+		core.SendFunc = func(_ context.Context, to peer.ID, msg blockless.Message) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+			require.Equal(t, requestID, wo.RequestID)
+
+			lock.Lock()
+			sent = append(sent, to)
+			lock.Unlock()
+
+			res, ok := standbyResults[to]
+			if ok {
+				head.workOrderResponses.Set(peerRequestKey(requestID, to), res)
+			}
+
+			return nil
+		}
+		head.Core = core
+
+		head.workOrderResponses.Set(peerRequestKey(requestID, healthy), ok)
+		head.workOrderResponses.Set(peerRequestKey(requestID, failing), failed)
+
+		return head, &sent
+	}
+
+	t.Run("failed work orders are re-dispatched", func(t *testing.T) {
+
+		head, sent := setup(t, 2, map[peer.ID]execute.NodeResult{
+			standbyOK: ok,
+			standbyKO: ok,
+		})
+
+		standby := &standbyPool{peers: []peer.ID{standbyOK, standbyKO}}
+
+		results, dispatched := head.gatherExecutionResultsWithFailover(context.Background(), requestID, &workOrder, []peer.ID{healthy, failing, silent}, standby)
+
+		require.Len(t, results, 3)
+		require.Contains(t, results, healthy)
+		require.Contains(t, results, standbyOK)
+		require.Contains(t, results, standbyKO)
+		require.NotContains(t, results, failing)
+
+		require.ElementsMatch(t, []peer.ID{standbyOK, standbyKO}, *sent)
+		require.ElementsMatch(t, []peer.ID{healthy, failing, silent, standbyOK, standbyKO}, dispatched)
+	})
+	t.Run("retry budget is respected", func(t *testing.T) {
+
+		head, sent := setup(t, 1, map[peer.ID]execute.NodeResult{
+			standbyOK: failed,
+			standbyKO: ok,
+		})
+
+		standby := &standbyPool{peers: []peer.ID{standbyOK, standbyKO}}
+
+		results, dispatched := head.gatherExecutionResultsWithFailover(context.Background(), requestID, &workOrder, []peer.ID{healthy, failing}, standby)
+
+		// Failed result from the standby peer is kept since there's no one else to take over.
+		require.Len(t, results, 2)
+		require.Equal(t, ok, results[healthy])
+		require.Equal(t, failed, results[standbyOK])
+
+		require.Equal(t, []peer.ID{standbyOK}, *sent)
+		require.Equal(t, []peer.ID{healthy, failing, standbyOK}, dispatched)
+	})
+	t.Run("no standby peers", func(t *testing.T) {
+
+		head, sent := setup(t, 2, nil)
+
+		results, dispatched := head.gatherExecutionResultsWithFailover(context.Background(), requestID, &workOrder, []peer.ID{healthy, failing, silent}, nil)
+
+		require.Len(t, results, 2)
+		require.Equal(t, ok, results[healthy])
+		require.Equal(t, failed, results[failing])
+
+		require.Empty(t, *sent)
+		require.Equal(t, []peer.ID{healthy, failing, silent}, dispatched)
+	})
+}
+
+func TestHead_GatherExecutionResultsPBFT(t *testing.T) {
+
+	const (
+		requestID = "dummy-request-id"
+	)
+
+	type replica struct {
+		id  peer.ID
+		key crypto.PrivKey
+	}
+
+	var (
+		replicas  = make([]replica, 4)
+		peers     = make([]peer.ID, 4)
+		timestamp = time.Now()
+	)
+	for i := range replicas {
+		key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		require.NoError(t, err)
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+
+		replicas[i] = replica{id: id, key: key}
+		peers[i] = id
+	}
+
+	// Create a result as the given replica would, signed by the given key.
+	result := func(t *testing.T, r replica, key crypto.PrivKey, stdout string) execute.NodeResult {
+		t.Helper()
+
+		res := execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: execute.RuntimeOutput{Stdout: stdout},
+				Usage:  execute.Usage{WallClockTime: time.Duration(mrand.Int())}, // Usage differs between replicas.
+			},
+			PBFT: execute.PBFTResultInfo{
+				RequestTimestamp: timestamp,
+				Replica:          r.id,
+			},
+		}
+		require.NoError(t, res.Sign(key))
+
+		return res
+	}
+
+	gather := func(t *testing.T, results map[peer.ID]execute.NodeResult) execute.ResultMap {
+		t.Helper()
+
+		head := createHeadNode(t)
+		head.cfg.ExecutionTimeout = 100 * time.Millisecond
+
+		for id, res := range results {
+			head.workOrderResponses.Set(peerRequestKey(requestID, id), res)
+		}
+
+		return head.gatherExecutionResultsPBFT(context.Background(), requestID, peers)
+	}
+
+	t.Run("matching results", func(t *testing.T) {
+
+		results := gather(t, map[peer.ID]execute.NodeResult{
+			replicas[0].id: result(t, replicas[0], replicas[0].key, "dummy-stdout"),
+			replicas[1].id: result(t, replicas[1], replicas[1].key, "dummy-stdout"),
+			replicas[2].id: result(t, replicas[2], replicas[2].key, "different-stdout"),
+		})
+
+		require.GreaterOrEqual(t, uint(len(results)), pbft.MinClusterResults(uint(len(peers))))
+		require.NotContains(t, results, replicas[2].id)
+		for id, res := range results {
+			// Signed results are returned as-is.
+			require.NoError(t, verifyResult(id, res))
+			require.Equal(t, "dummy-stdout", res.Result.Result.Stdout)
+		}
+	})
+	t.Run("no matching results", func(t *testing.T) {
+
+		results := gather(t, map[peer.ID]execute.NodeResult{
+			replicas[0].id: result(t, replicas[0], replicas[0].key, "dummy-stdout-1"),
+			replicas[1].id: result(t, replicas[1], replicas[1].key, "dummy-stdout-2"),
+			replicas[2].id: result(t, replicas[2], replicas[2].key, "dummy-stdout-3"),
+		})
+
+		require.Empty(t, results)
+	})
+	t.Run("results not signed by sender are not counted", func(t *testing.T) {
+
+		results := gather(t, map[peer.ID]execute.NodeResult{
+			replicas[0].id: result(t, replicas[0], replicas[0].key, "dummy-stdout"),
+			replicas[1].id: result(t, replicas[0], replicas[0].key, "dummy-stdout"), // Relaying the result of another replica.
+			replicas[2].id: result(t, replicas[2], replicas[3].key, "dummy-stdout"), // Forged signature.
+		})
+
+		require.Empty(t, results)
+	})
+	t.Run("results claiming a different replica are not counted", func(t *testing.T) {
+
+		results := gather(t, map[peer.ID]execute.NodeResult{
+			replicas[0].id: result(t, replicas[0], replicas[0].key, "dummy-stdout"),
+			replicas[1].id: result(t, replicas[0], replicas[1].key, "dummy-stdout"),
+		})
+
+		require.Empty(t, results)
+	})
+}
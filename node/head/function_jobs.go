@@ -0,0 +1,127 @@
+package head
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+// functionJobs keeps track of the most recent function job of a kind (e.g. install) for each function.
+type functionJobs struct {
+	kind blockless.FunctionJobKind
+
+	sync.Mutex
+	m map[string]*functionJob
+}
+
+type functionJob struct {
+	job   blockless.FunctionJob
+	peers map[peer.ID]blockless.PeerJobStatus
+}
+
+func newFunctionJobs(kind blockless.FunctionJobKind) *functionJobs {
+
+	j := functionJobs{
+		kind: kind,
+		m:    make(map[string]*functionJob),
+	}
+
+	return &j
+}
+
+// start creates and records a new job for the function, replacing any previous job for the same function.
+func (j *functionJobs) start(cid string, manifestURL string, topic string) blockless.FunctionJob {
+	j.Lock()
+	defer j.Unlock()
+
+	job := blockless.FunctionJob{
+		ID:          newRequestID(),
+		Kind:        j.kind,
+		CID:         cid,
+		ManifestURL: manifestURL,
+		Topic:       topic,
+		CreatedAt:   time.Now().UTC(),
+		Peers:       []blockless.PeerJobStatus{},
+	}
+
+	j.m[cid] = &functionJob{
+		job:   job,
+		peers: make(map[peer.ID]blockless.PeerJobStatus),
+	}
+
+	return job
+}
+
+// discard removes the job, unless it was already replaced by a newer one.
+func (j *functionJobs) discard(job blockless.FunctionJob) {
+	j.Lock()
+	defer j.Unlock()
+
+	current, ok := j.m[job.CID]
+	if ok && current.job.ID == job.ID {
+		delete(j.m, job.CID)
+	}
+}
+
+// record records the job outcome reported by the peer. Returns false if there is no job for the function.
+// Later reports from the same peer override earlier ones.
+func (j *functionJobs) record(cid string, id peer.ID, state blockless.JobState, message string) bool {
+	j.Lock()
+	defer j.Unlock()
+
+	job, ok := j.m[cid]
+	if !ok {
+		return false
+	}
+
+	status := blockless.PeerJobStatus{
+		Peer:      id,
+		State:     state,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if state == blockless.JobFailed {
+		status.Error = message
+	}
+
+	job.peers[id] = status
+
+	return true
+}
+
+// get returns the job for the function, with peers ordered by ID.
+func (j *functionJobs) get(cid string) (blockless.FunctionJob, bool) {
+	j.Lock()
+	defer j.Unlock()
+
+	job, ok := j.m[cid]
+	if !ok {
+		return blockless.FunctionJob{}, false
+	}
+
+	out := job.job
+	out.Succeeded = 0
+	out.Failed = 0
+	out.Peers = make([]blockless.PeerJobStatus, 0, len(job.peers))
+	for _, status := range job.peers {
+
+		switch status.State {
+		case blockless.JobSucceeded:
+			out.Succeeded++
+		case blockless.JobFailed:
+			out.Failed++
+		}
+
+		out.Peers = append(out.Peers, status)
+	}
+
+	slices.SortFunc(out.Peers, func(a, b blockless.PeerJobStatus) int {
+		return strings.Compare(string(a.Peer), string(b.Peer))
+	})
+
+	return out, true
+}
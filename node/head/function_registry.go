@@ -0,0 +1,91 @@
+package head
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+// functionRegistry keeps track of which workers reported having which functions installed.
+type functionRegistry struct {
+	sync.Mutex
+	m map[string]map[peer.ID]time.Time
+}
+
+func newFunctionRegistry() *functionRegistry {
+
+	r := functionRegistry{
+		m: make(map[string]map[peer.ID]time.Time),
+	}
+
+	return &r
+}
+
+// report records that the worker has the function installed.
+func (r *functionRegistry) report(cid string, id peer.ID) {
+	r.Lock()
+	defer r.Unlock()
+
+	workers, ok := r.m[cid]
+	if !ok {
+		workers = make(map[peer.ID]time.Time)
+		r.m[cid] = workers
+	}
+
+	workers[id] = time.Now().UTC()
+}
+
+// remove records that the worker no longer has the function installed.
+func (r *functionRegistry) remove(cid string, id peer.ID) {
+	r.Lock()
+	defer r.Unlock()
+
+	workers, ok := r.m[cid]
+	if !ok {
+		return
+	}
+
+	delete(workers, id)
+	if len(workers) == 0 {
+		delete(r.m, cid)
+	}
+}
+
+// list returns all functions in the registry, ordered by CID. Workers are ordered by peer ID.
+func (r *functionRegistry) list() []blockless.InstalledFunction {
+	r.Lock()
+	defer r.Unlock()
+
+	functions := make([]blockless.InstalledFunction, 0, len(r.m))
+	for cid, workers := range r.m {
+
+		fn := blockless.InstalledFunction{
+			CID:     cid,
+			Workers: make([]blockless.FunctionWorker, 0, len(workers)),
+		}
+
+		for id, reported := range workers {
+			fn.Workers = append(fn.Workers, blockless.FunctionWorker{
+				Peer:       id,
+				ReportedAt: reported,
+			})
+		}
+
+		slices.SortFunc(fn.Workers, func(a, b blockless.FunctionWorker) int {
+			return strings.Compare(string(a.Peer), string(b.Peer))
+		})
+
+		functions = append(functions, fn)
+	}
+
+	slices.SortFunc(functions, func(a, b blockless.InstalledFunction) int {
+		return strings.Compare(a.CID, b.CID)
+	})
+
+	return functions
+}
@@ -3,28 +3,48 @@ package head
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 
 	"github.com/armon/go-metrics"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"github.com/blocklessnetwork/b7s/info"
+	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/execute"
 	"github.com/blocklessnetwork/b7s/models/response"
 	"github.com/blocklessnetwork/b7s/node"
+	"github.com/blocklessnetwork/b7s/node/internal/syncmap"
 	"github.com/blocklessnetwork/b7s/node/internal/waitmap"
 )
 
 type HeadNode struct {
 	node.Core
 
-	cfg Config
+	cfg   Config
+	store blockless.Store
+	http  *http.Client
 
 	rollCall           *rollCallQueue
+	peerStats          *peerStats
+	reputation         *reputationTracker
+	functions          *functionRegistry
+	installJobs        *functionJobs
+	uninstallJobs      *functionJobs
+	executions         *syncmap.Map[string, execute.State]
+	cancels            *syncmap.Map[string, context.CancelCauseFunc]
+	workflows          *syncmap.Map[string, struct{}] // Workflows running on this node.
+	events             *eventBroker
+	idempotency        *idempotencyKeys
+	admission          *admissionQueue
+	scheduleLock       sync.Mutex // Guards schedule updates.
 	consensusResponses *waitmap.WaitMap[string, response.FormCluster]
 	workOrderResponses *waitmap.WaitMap[string, execute.NodeResult]
+	unverifiedResults  *syncmap.Map[string, struct{}] // Results that failed signature verification, by peer request key.
 }
 
-func New(core node.Core, options ...Option) (*HeadNode, error) {
+func New(core node.Core, store blockless.Store, options ...Option) (*HeadNode, error) {
 
 	// Initialize config.
 	cfg := DefaultConfig
@@ -38,12 +58,29 @@ func New(core node.Core, options ...Option) (*HeadNode, error) {
 	}
 
 	head := &HeadNode{
-		Core: core,
-		cfg:  cfg,
+		Core:  core,
+		cfg:   cfg,
+		store: store,
+		http: &http.Client{
+			Timeout:   manifestFetchTimeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
 
 		rollCall:           newQueue(rollCallQueueBufferSize),
+		peerStats:          newPeerStats(),
+		reputation:         newReputationTracker(store),
+		functions:          newFunctionRegistry(),
+		installJobs:        newFunctionJobs(blockless.JobInstall),
+		uninstallJobs:      newFunctionJobs(blockless.JobUninstall),
+		executions:         syncmap.New[string, execute.State](),
+		cancels:            syncmap.New[string, context.CancelCauseFunc](),
+		workflows:          syncmap.New[string, struct{}](),
+		events:             newEventBroker(),
+		idempotency:        newIdempotencyKeys(cfg.IdempotencyWindow),
+		admission:          newAdmissionQueue(cfg.MaxConcurrentExecutions, cfg.QueueSize),
 		consensusResponses: waitmap.New[string, response.FormCluster](0),
 		workOrderResponses: waitmap.New[string, execute.NodeResult](executionResultCacheSize),
+		unverifiedResults:  syncmap.New[string, struct{}](),
 	}
 
 	head.Metrics().SetGaugeWithLabels(node.NodeInfoMetric, 1,
@@ -57,6 +94,19 @@ func New(core node.Core, options ...Option) (*HeadNode, error) {
 }
 
 func (h *HeadNode) Run(ctx context.Context) error {
+
+	// Workflows interrupted by a restart will never complete - mark them as failed.
+	err := h.failInterruptedWorkflows(ctx)
+	if err != nil {
+		h.Log().Error().Err(err).Msg("could not fail interrupted workflows")
+	}
+
+	// Start the cleanup of expired execution results in the background.
+	go h.runResultCleanupLoop(ctx)
+
+	// Start running scheduled executions in the background.
+	go h.runScheduler(ctx)
+
 	return h.Core.Run(ctx, h.process)
 }
 
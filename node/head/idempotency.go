@@ -0,0 +1,192 @@
+package head
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// idempotencyRecord links an idempotency key to the execution it started.
+type idempotencyRecord struct {
+	requestID string
+	digest    string        // Digest of the request, so we can tell if the key is reused for a different request.
+	created   time.Time     // When was the execution started.
+	completed time.Time     // When was the execution done.
+	done      chan struct{} // Closed once the execution is done.
+}
+
+func (r *idempotencyRecord) running() bool {
+	select {
+	case <-r.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// idempotencyKeys keeps track of executions started with an idempotency key, so that repeated submissions do not start new executions.
+type idempotencyKeys struct {
+	sync.Mutex
+	window  time.Duration
+	records map[string]*idempotencyRecord
+}
+
+func newIdempotencyKeys(window time.Duration) *idempotencyKeys {
+
+	k := idempotencyKeys{
+		window:  window,
+		records: make(map[string]*idempotencyRecord),
+	}
+
+	return &k
+}
+
+// claim returns the record of an earlier execution with the same key, if it's still running or it was completed
+// within the idempotency window. Otherwise, the key is assigned to the given request and nil is returned.
+func (k *idempotencyKeys) claim(key string, digest string, requestID string, now time.Time) (*idempotencyRecord, error) {
+	k.Lock()
+	defer k.Unlock()
+
+	// Drop records outside of the window while we're at it.
+	for key, record := range k.records {
+		if k.expired(record, now) {
+			delete(k.records, key)
+		}
+	}
+
+	record, ok := k.records[key]
+	if ok {
+		if record.digest != digest {
+			return nil, blockless.ErrIdempotencyKeyConflict
+		}
+
+		return record, nil
+	}
+
+	k.records[key] = &idempotencyRecord{
+		requestID: requestID,
+		digest:    digest,
+		created:   now,
+		done:      make(chan struct{}),
+	}
+
+	return nil, nil
+}
+
+// complete marks the execution started with the given key as done. Idempotency window starts from this point.
+func (k *idempotencyKeys) complete(key string, requestID string, now time.Time) {
+	k.Lock()
+	defer k.Unlock()
+
+	record, ok := k.records[key]
+	if !ok || record.requestID != requestID || !record.running() {
+		return
+	}
+
+	record.completed = now
+	close(record.done)
+}
+
+// forget removes the key if it belongs to the given request, so that it can be used again.
+func (k *idempotencyKeys) forget(key string, requestID string) {
+	k.Lock()
+	defer k.Unlock()
+
+	record, ok := k.records[key]
+	if !ok || record.requestID != requestID {
+		return
+	}
+
+	delete(k.records, key)
+}
+
+// expired returns true if the execution is done and was completed outside of the idempotency window.
+// Records of running executions never expire, regardless of how long the execution takes.
+func (k *idempotencyKeys) expired(record *idempotencyRecord, now time.Time) bool {
+	return !record.running() && now.Sub(record.completed) > k.window
+}
+
+// claimExecution assigns a request ID to the execution request. If the request has an idempotency key seen recently,
+// the record of the earlier execution is returned instead, and no new execution should be started.
+func (h *HeadNode) claimExecution(req execute.Request) (string, *idempotencyRecord, error) {
+
+	requestID := newRequestID()
+	if req.IdempotencyKey == "" {
+		return requestID, nil, nil
+	}
+
+	digest, err := requestDigest(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not determine request digest: %w", err)
+	}
+
+	existing, err := h.idempotency.claim(req.IdempotencyKey, digest, requestID, time.Now())
+	if err != nil {
+		return "", nil, fmt.Errorf("could not claim idempotency key: %w", err)
+	}
+
+	if existing != nil {
+		h.Log().Info().
+			Str("request", existing.requestID).
+			Str("idempotency_key", req.IdempotencyKey).
+			Msg("execution request already submitted, using earlier execution")
+
+		return existing.requestID, existing, nil
+	}
+
+	return requestID, nil, nil
+}
+
+// executeOnce runs the execution, unless an execution with the same idempotency key is already running or was done recently.
+// In that case it waits for the earlier execution to complete and returns its outcome.
+func (h *HeadNode) executeOnce(ctx context.Context, req request.Execute) (string, codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	requestID, existing, err := h.claimExecution(req.Request)
+	if err != nil {
+		return "", codes.Invalid, nil, execute.Cluster{}, err
+	}
+
+	if existing == nil {
+
+		ticket, err := h.admitExecution(requestID, req.Request)
+		if err != nil {
+			return "", codes.TooManyRequests, nil, execute.Cluster{}, err
+		}
+
+		code, results, cluster, err := h.runExecution(ctx, requestID, req, ticket)
+		return requestID, code, results, cluster, err
+	}
+
+	select {
+	case <-existing.done:
+	case <-ctx.Done():
+		return requestID, codes.Timeout, nil, execute.Cluster{}, fmt.Errorf("could not wait for earlier execution: %w", ctx.Err())
+	}
+
+	record, err := h.executionResult(ctx, requestID)
+	if err != nil {
+		return requestID, codes.Error, nil, execute.Cluster{}, fmt.Errorf("could not retrieve result of earlier execution: %w", err)
+	}
+
+	return requestID, record.Code, record.Results, record.Cluster, nil
+}
+
+// requestDigest returns the digest of the execution request, ignoring the idempotency key.
+func requestDigest(req execute.Request) (string, error) {
+
+	req.IdempotencyKey = ""
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("could not encode request: %w", err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
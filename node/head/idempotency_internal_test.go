@@ -0,0 +1,166 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/store"
+	"github.com/blocklessnetwork/b7s/store/codec"
+	"github.com/blocklessnetwork/b7s/testing/helpers"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+
+	const (
+		key    = "dummy-key"
+		digest = "dummy-digest"
+		window = time.Minute
+	)
+
+	now := time.Now()
+
+	t.Run("first claim succeeds", func(t *testing.T) {
+		keys := newIdempotencyKeys(window)
+
+		existing, err := keys.claim(key, digest, "request-1", now)
+		require.NoError(t, err)
+		require.Nil(t, existing)
+	})
+	t.Run("repeated claim returns running execution", func(t *testing.T) {
+		keys := newIdempotencyKeys(window)
+
+		_, err := keys.claim(key, digest, "request-1", now)
+		require.NoError(t, err)
+
+		// Running executions are returned even outside of the window.
+		existing, err := keys.claim(key, digest, "request-2", now.Add(2*window))
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		require.Equal(t, "request-1", existing.requestID)
+		require.True(t, existing.running())
+	})
+	t.Run("completed execution is returned within the window", func(t *testing.T) {
+		keys := newIdempotencyKeys(window)
+
+		_, err := keys.claim(key, digest, "request-1", now)
+		require.NoError(t, err)
+		keys.complete(key, "request-1", now)
+
+		existing, err := keys.claim(key, digest, "request-2", now.Add(window/2))
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		require.Equal(t, "request-1", existing.requestID)
+		require.False(t, existing.running())
+	})
+	t.Run("completed execution expires after the window", func(t *testing.T) {
+		keys := newIdempotencyKeys(window)
+
+		_, err := keys.claim(key, digest, "request-1", now)
+		require.NoError(t, err)
+		keys.complete(key, "request-1", now)
+
+		existing, err := keys.claim(key, digest, "request-2", now.Add(2*window))
+		require.NoError(t, err)
+		require.Nil(t, existing)
+	})
+	t.Run("window is measured from completion", func(t *testing.T) {
+		keys := newIdempotencyKeys(window)
+
+		_, err := keys.claim(key, digest, "request-1", now)
+		require.NoError(t, err)
+
+		// Execution took longer than the window.
+		keys.complete(key, "request-1", now.Add(2*window))
+
+		existing, err := keys.claim(key, digest, "request-2", now.Add(2*window+window/2))
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		require.Equal(t, "request-1", existing.requestID)
+
+		existing, err = keys.claim(key, digest, "request-3", now.Add(4*window))
+		require.NoError(t, err)
+		require.Nil(t, existing)
+	})
+	t.Run("key reused for a different request", func(t *testing.T) {
+		keys := newIdempotencyKeys(window)
+
+		_, err := keys.claim(key, digest, "request-1", now)
+		require.NoError(t, err)
+
+		_, err = keys.claim(key, "different-digest", "request-2", now)
+		require.ErrorIs(t, err, blockless.ErrIdempotencyKeyConflict)
+	})
+}
+
+func TestHead_ExecuteOnce(t *testing.T) {
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	req := request.Execute{
+		Request: mocks.GenericExecutionRequest,
+	}
+	req.IdempotencyKey = "dummy-key"
+
+	// Claim the key and store the outcome of the execution, as if it's been executed.
+	requestID, existing, err := head.claimExecution(req.Request)
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	record := mocks.GenericExecutionRecord
+	record.RequestID = requestID
+	record.Code = codes.OK
+	record.CompletedAt = time.Now()
+	head.saveExecutionResult(context.Background(), record)
+
+	t.Run("repeated submission waits for earlier execution", func(t *testing.T) {
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			head.idempotency.complete(req.IdempotencyKey, requestID, time.Now())
+		}()
+
+		id, code, results, _, err := head.executeOnce(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, requestID, id)
+		require.Equal(t, codes.OK, code)
+		require.Equal(t, record.Results, results)
+	})
+	t.Run("repeated submission gets stored result", func(t *testing.T) {
+
+		id, code, _, _, err := head.executeOnce(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, requestID, id)
+		require.Equal(t, codes.OK, code)
+	})
+	t.Run("async submission returns earlier request ID", func(t *testing.T) {
+
+		id, err := head.ExecuteFunctionAsync(context.Background(), req.Request, "")
+		require.NoError(t, err)
+		require.Equal(t, requestID, id)
+	})
+	t.Run("stream replays outcome of earlier execution", func(t *testing.T) {
+
+		id, events, err := head.ExecuteFunctionStream(context.Background(), req.Request, "")
+		require.NoError(t, err)
+		require.Equal(t, requestID, id)
+
+		var last execute.Event
+		for event := range events {
+			last = event
+		}
+		require.Equal(t, execute.EventDone, last.Type)
+		require.Equal(t, codes.OK, last.Code)
+	})
+}
@@ -0,0 +1,63 @@
+package head
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blocklessnetwork/b7s/info"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+// NodeInfo returns information about the head node.
+func (h *HeadNode) NodeInfo(ctx context.Context) blockless.NodeInfo {
+
+	nodeInfo := blockless.NodeInfo{
+		ID:        h.ID(),
+		Role:      blockless.HeadNode.String(),
+		Version:   info.VcsVersion(),
+		Addresses: h.Host().Addresses(),
+		Topics:    h.Topics(),
+	}
+
+	return nodeInfo
+}
+
+// KnownPeers returns the peers the head node has seen.
+func (h *HeadNode) KnownPeers(ctx context.Context) ([]blockless.Peer, error) {
+
+	peers, err := h.store.RetrievePeers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve peers: %w", err)
+	}
+
+	return peers, nil
+}
+
+// ConnectedPeers returns the peers the head node is currently connected to.
+func (h *HeadNode) ConnectedPeers(ctx context.Context) []blockless.ConnectedPeer {
+
+	network := h.Host().Network()
+
+	ids := network.Peers()
+	peers := make([]blockless.ConnectedPeer, 0, len(ids))
+	for _, id := range ids {
+
+		cp := blockless.ConnectedPeer{
+			ID:        id,
+			Addresses: make([]string, 0),
+		}
+
+		for _, conn := range network.ConnsToPeer(id) {
+			cp.Addresses = append(cp.Addresses, conn.RemoteMultiaddr().String())
+		}
+
+		peers = append(peers, cp)
+	}
+
+	return peers
+}
+
+// InstalledFunctions returns the functions workers reported having installed, along with the workers that reported them.
+func (h *HeadNode) InstalledFunctions(ctx context.Context) []blockless.InstalledFunction {
+	return h.functions.list()
+}
@@ -0,0 +1,62 @@
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_FunctionRegistry(t *testing.T) {
+
+	var (
+		ctx    = context.Background()
+		first  = mocks.GenericPeerIDs[0]
+		second = mocks.GenericPeerIDs[1]
+	)
+
+	head := createHeadNode(t)
+
+	// Successful install.
+	err := head.processInstallFunctionResponse(ctx, first, response.InstallFunction{Code: codes.Accepted, CID: "function-b"})
+	require.NoError(t, err)
+
+	// Failed install is not recorded.
+	err = head.processInstallFunctionResponse(ctx, second, response.InstallFunction{Code: codes.Error, CID: "function-b"})
+	require.NoError(t, err)
+
+	// Peers only report for roll calls for installed functions.
+	err = head.processRollCallResponse(ctx, second, response.RollCall{Code: codes.Accepted, FunctionID: "function-a", RequestID: mocks.GenericUUID.String()})
+	require.NoError(t, err)
+
+	// Declined roll call is not recorded.
+	err = head.processRollCallResponse(ctx, first, response.RollCall{Code: codes.NotAvailable, FunctionID: "function-a", RequestID: mocks.GenericUUID.String()})
+	require.NoError(t, err)
+
+	functions := head.InstalledFunctions(ctx)
+	require.Len(t, functions, 2)
+
+	require.Equal(t, "function-a", functions[0].CID)
+	require.Len(t, functions[0].Workers, 1)
+	require.Equal(t, second, functions[0].Workers[0].Peer)
+	require.False(t, functions[0].Workers[0].ReportedAt.IsZero())
+
+	require.Equal(t, "function-b", functions[1].CID)
+	require.Len(t, functions[1].Workers, 1)
+	require.Equal(t, first, functions[1].Workers[0].Peer)
+}
+
+func TestHead_NodeInfo(t *testing.T) {
+
+	head := createHeadNode(t)
+
+	info := head.NodeInfo(context.Background())
+	require.Equal(t, head.ID(), info.ID)
+	require.Equal(t, "head", info.Role)
+	require.Equal(t, head.Host().Addresses(), info.Addresses)
+	require.Equal(t, head.Topics(), info.Topics)
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/response"
 )
 
@@ -15,5 +17,45 @@ func (h *HeadNode) processInstallFunctionResponse(ctx context.Context, from peer
 		Str("cid", res.CID).
 		Msg("function install response received")
 
+	state := blockless.JobFailed
+	if res.Code == codes.Accepted || res.Code == codes.OK {
+		state = blockless.JobSucceeded
+		h.functions.report(res.CID, from)
+	}
+
+	tracked := h.installJobs.record(res.CID, from, state, res.Message)
+	if !tracked {
+		h.Log().Debug().Stringer("from", from).Str("cid", res.CID).Msg("received install response for an untracked function")
+	}
+
+	return nil
+}
+
+func (h *HeadNode) processUninstallFunctionResponse(ctx context.Context, from peer.ID, res response.UninstallFunction) error {
+
+	h.Log().Trace().
+		Stringer("from", from).
+		Str("cid", res.CID).
+		Msg("function uninstall response received")
+
+	// Worker either removed the function or did not have it in the first place.
+	state := blockless.JobFailed
+	if res.Code == codes.OK || res.Code == codes.NotFound {
+		state = blockless.JobSucceeded
+		h.functions.remove(res.CID, from)
+	} else {
+		h.Log().Warn().
+			Stringer("from", from).
+			Str("cid", res.CID).
+			Str("code", res.Code.String()).
+			Str("message", res.Message).
+			Msg("worker could not uninstall function")
+	}
+
+	tracked := h.uninstallJobs.record(res.CID, from, state, res.Message)
+	if !tracked {
+		h.Log().Debug().Stringer("from", from).Str("cid", res.CID).Msg("received uninstall response for an untracked function")
+	}
+
 	return nil
 }
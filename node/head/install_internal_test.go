@@ -0,0 +1,229 @@
+package head
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_InstallJob(t *testing.T) {
+
+	var (
+		ctx    = context.Background()
+		cid    = mocks.GenericFunctionRecord.CID
+		first  = mocks.GenericPeerIDs[0]
+		second = mocks.GenericPeerIDs[1]
+	)
+
+	t.Run("worker outcomes are recorded", func(t *testing.T) {
+
+		head := createHeadNode(t)
+
+		_, err := head.InstallStatus(ctx, cid)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+
+		job, err := head.PublishFunctionInstall(ctx, "", cid, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, job.ID)
+		require.Equal(t, blockless.JobInstall, job.Kind)
+		require.Equal(t, cid, job.CID)
+		require.Equal(t, blockless.DefaultTopic, job.Topic)
+
+		err = head.processInstallFunctionResponse(ctx, first, response.InstallFunction{Code: codes.Accepted, CID: cid, Message: "installed"})
+		require.NoError(t, err)
+		err = head.processInstallFunctionResponse(ctx, second, response.InstallFunction{Code: codes.Error, CID: cid, Message: "could not download function"})
+		require.NoError(t, err)
+
+		status, err := head.InstallStatus(ctx, cid)
+		require.NoError(t, err)
+		require.Equal(t, job.ID, status.ID)
+		require.Equal(t, uint(1), status.Succeeded)
+		require.Equal(t, uint(1), status.Failed)
+		require.Len(t, status.Peers, 2)
+
+		for _, peer := range status.Peers {
+			switch peer.Peer {
+			case first:
+				require.Equal(t, blockless.JobSucceeded, peer.State)
+				require.Empty(t, peer.Error)
+			case second:
+				require.Equal(t, blockless.JobFailed, peer.State)
+				require.Equal(t, "could not download function", peer.Error)
+			default:
+				require.FailNow(t, "unexpected peer", peer.Peer)
+			}
+		}
+
+		// Publishing again starts a new job.
+		job2, err := head.PublishFunctionInstall(ctx, "", cid, "")
+		require.NoError(t, err)
+		require.NotEqual(t, job.ID, job2.ID)
+
+		status, err = head.InstallStatus(ctx, cid)
+		require.NoError(t, err)
+		require.Equal(t, job2.ID, status.ID)
+		require.Empty(t, status.Peers)
+	})
+	t.Run("job is discarded if publish fails", func(t *testing.T) {
+
+		core := mocks.BaselineNodeCore(t)
+		core.PublishToTopicFunc = func(context.Context, string, blockless.Message) error {
+			return mocks.GenericError
+		}
+
+		head, err := New(core, mocks.BaselineStore(t))
+		require.NoError(t, err)
+
+		_, err = head.PublishFunctionInstall(ctx, "", cid, "")
+		require.Error(t, err)
+
+		_, err = head.InstallStatus(ctx, cid)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+}
+
+func TestHead_UninstallFunction(t *testing.T) {
+
+	var (
+		ctx    = context.Background()
+		cid    = mocks.GenericFunctionRecord.CID
+		first  = mocks.GenericPeerIDs[0]
+		second = mocks.GenericPeerIDs[1]
+	)
+
+	head := createHeadNode(t)
+
+	head.functions.report(cid, first)
+	head.functions.report(cid, second)
+
+	_, err := head.UninstallStatus(ctx, cid)
+	require.ErrorIs(t, err, blockless.ErrNotFound)
+
+	job, err := head.PublishFunctionUninstall(ctx, cid, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, job.ID)
+	require.Equal(t, cid, job.CID)
+	require.Equal(t, blockless.DefaultTopic, job.Topic)
+
+	// Failed uninstall leaves the function registered for the worker.
+	err = head.processUninstallFunctionResponse(ctx, first, response.UninstallFunction{Code: codes.Error, CID: cid, Message: "could not remove function files"})
+	require.NoError(t, err)
+	require.Len(t, head.InstalledFunctions(ctx)[0].Workers, 2)
+
+	err = head.processUninstallFunctionResponse(ctx, first, response.UninstallFunction{Code: codes.OK, CID: cid})
+	require.NoError(t, err)
+
+	functions := head.InstalledFunctions(ctx)
+	require.Len(t, functions, 1)
+	require.Len(t, functions[0].Workers, 1)
+	require.Equal(t, second, functions[0].Workers[0].Peer)
+
+	// Worker that did not have the function installed is removed too.
+	err = head.processUninstallFunctionResponse(ctx, second, response.UninstallFunction{Code: codes.NotFound, CID: cid})
+	require.NoError(t, err)
+	require.Empty(t, head.InstalledFunctions(ctx))
+
+	// Later report from a worker overrides the earlier failure.
+	status, err := head.UninstallStatus(ctx, cid)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, status.ID)
+	require.Equal(t, uint(2), status.Succeeded)
+	require.Equal(t, uint(0), status.Failed)
+	require.Len(t, status.Peers, 2)
+}
+
+func TestHead_UninstallJob_FailedUninstall(t *testing.T) {
+
+	var (
+		ctx  = context.Background()
+		cid  = mocks.GenericFunctionRecord.CID
+		peer = mocks.GenericPeerIDs[0]
+	)
+
+	head := createHeadNode(t)
+
+	_, err := head.PublishFunctionUninstall(ctx, cid, "")
+	require.NoError(t, err)
+
+	err = head.processUninstallFunctionResponse(ctx, peer, response.UninstallFunction{Code: codes.Error, CID: cid, Message: "function in use"})
+	require.NoError(t, err)
+
+	status, err := head.UninstallStatus(ctx, cid)
+	require.NoError(t, err)
+	require.Equal(t, blockless.JobUninstall, status.Kind)
+	require.Equal(t, uint(0), status.Succeeded)
+	require.Equal(t, uint(1), status.Failed)
+	require.Len(t, status.Peers, 1)
+	require.Equal(t, blockless.JobFailed, status.Peers[0].State)
+	require.Equal(t, "function in use", status.Peers[0].Error)
+}
+
+func TestHead_PublishFunctionInstall_ResolvesCID(t *testing.T) {
+
+	const (
+		checksum = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+		cid      = "bafkreifzjut3te2nhyekklss27nh3k72ysco7y32koao5eei66wof36n5e"
+	)
+
+	ctx := context.Background()
+
+	// Serve manifests for the same function archive from different locations.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+
+		var manifest blockless.FunctionManifest
+		switch req.URL.Path {
+		case "/manifest.json":
+			manifest.Deployment = blockless.Deployment{URI: "https://example.com/function.tar.gz", Checksum: checksum}
+		case "/legacy/manifest.json":
+			manifest.Runtime = blockless.Runtime{URL: "/function.tar.gz", Checksum: checksum}
+		case "/no-checksum/manifest.json":
+			manifest.Deployment = blockless.Deployment{URI: "https://example.com/function.tar.gz"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(manifest))
+	}))
+	defer srv.Close()
+
+	head := createHeadNode(t)
+
+	t.Run("same archive maps to the same CID", func(t *testing.T) {
+
+		for _, path := range []string{"/manifest.json", "/legacy/manifest.json"} {
+
+			uri := srv.URL + path
+
+			job, err := head.PublishFunctionInstall(ctx, uri, "", "")
+			require.NoError(t, err)
+			require.Equal(t, cid, job.CID)
+			require.Equal(t, uri, job.ManifestURL)
+		}
+	})
+	t.Run("manifest without checksum falls back to URI hash", func(t *testing.T) {
+
+		uri := srv.URL + "/no-checksum/manifest.json"
+
+		job, err := head.PublishFunctionInstall(ctx, uri, "", "")
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("%x", sha256.Sum256([]byte(uri))), job.CID)
+		require.Equal(t, uri, job.ManifestURL)
+	})
+	t.Run("missing manifest is rejected", func(t *testing.T) {
+
+		_, err := head.PublishFunctionInstall(ctx, srv.URL+"/missing/manifest.json", "", "")
+		require.Error(t, err)
+	})
+}
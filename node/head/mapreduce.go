@@ -0,0 +1,140 @@
+package head
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// ExecuteMapReduce splits the input into shards and has each worker process a different shard. Once all shards are processed,
+// the reduce function is executed over the shard outputs to produce the final result.
+func (h *HeadNode) ExecuteMapReduce(ctx context.Context, req execute.MapReduceRequest, subgroup string) (codes.Code, string, execute.MapReduceResult, error) {
+
+	requestID := newRequestID()
+
+	// Request shared by all shards, used for the roll call.
+	base := request.Execute{
+		Request: execute.Request{
+			FunctionID: req.FunctionID,
+			Method:     req.Method,
+			Config:     req.Config,
+		},
+		Topic: subgroup,
+	}
+
+	// Both phases take a single place in the execution queue.
+	ticket, err := h.admitExecution(requestID, base.Request)
+	if err != nil {
+		return codes.TooManyRequests, "", execute.MapReduceResult{}, err
+	}
+
+	err = h.waitForAdmission(ctx, ticket)
+	if err != nil {
+		return codes.NotAvailable, requestID, execute.MapReduceResult{}, fmt.Errorf("could not start map-reduce execution (request: %s): %w", requestID, err)
+	}
+	defer h.releaseExecution()
+
+	code, result, err := h.executeMapReduce(ctx, requestID, base, req)
+	if err != nil {
+		h.Log().Error().Str("request", requestID).Err(err).Msg("map-reduce execution failed")
+	}
+
+	return code, requestID, result, err
+}
+
+func (h *HeadNode) executeMapReduce(ctx context.Context, requestID string, base request.Execute, req execute.MapReduceRequest) (codes.Code, execute.MapReduceResult, error) {
+
+	h.Metrics().IncrCounterWithLabels(mapReduceExecutionsMetric, 1,
+		[]metrics.Label{
+			{Name: "function", Value: req.FunctionID},
+			{Name: "reduce_function", Value: req.Reduce.FunctionID},
+		})
+
+	log := h.Log().With().
+		Str("request", requestID).
+		Str("function", req.FunctionID).
+		Str("reduce_function", req.Reduce.FunctionID).
+		Logger()
+
+	log.Info().Msg("processing map-reduce execution request")
+
+	shards, err := h.executeMap(ctx, requestID, base, req)
+	if err != nil {
+		code := codes.Error
+		if errors.Is(err, blockless.ErrRollCallTimeout) {
+			code = codes.Timeout
+		}
+
+		return code, execute.MapReduceResult{}, err
+	}
+
+	result := execute.MapReduceResult{
+		Shards: shards,
+	}
+
+	// Reduce function needs output of every shard.
+	outputs := make([]string, 0, len(shards))
+	for _, shard := range shards {
+		if shard.Result.Code != codes.OK {
+			return codes.PartialContent, result, fmt.Errorf("shard %d not processed: %w", shard.Index, blockless.ErrShardFailed)
+		}
+
+		outputs = append(outputs, shard.Result.Result.Result.Stdout)
+	}
+
+	log.Info().Int("shards", len(shards)).Msg("map phase complete, executing reduce function")
+
+	reduceID := reduceRequestID(requestID)
+	reduce := request.Execute{
+		Request: req.ReduceRequest(outputs),
+		Topic:   base.Topic,
+	}
+
+	// Reduce execution runs in the place the map-reduce request took in the execution queue.
+	code, results, cluster, err := h.runExecution(ctx, reduceID, reduce, nil)
+
+	result.Results = results
+	result.Cluster = cluster
+
+	if err != nil {
+		return code, result, fmt.Errorf("could not execute reduce function: %w", err)
+	}
+
+	log.Info().Stringer("code", code).Msg("map-reduce execution complete")
+
+	return code, result, nil
+}
+
+// executeMap splits the input into shards and spreads them across the workers. Shard results are returned in shard order.
+func (h *HeadNode) executeMap(ctx context.Context, requestID string, base request.Execute, req execute.MapReduceRequest) ([]execute.BatchItemResult, error) {
+
+	defer h.rollCall.remove(requestID)
+
+	workers, standby, err := h.executeRollCall(ctx, requestID, base, 0)
+	if err != nil {
+		return nil, fmt.Errorf("could not roll call peers (request: %s): %w", requestID, err)
+	}
+
+	batch := req.Map(cmp.Or(req.Shards, len(workers)))
+
+	h.Log().Info().
+		Str("request", requestID).
+		Strs("peers", blockless.PeerIDsToStr(workers)).
+		Int("shards", len(batch.Items)).
+		Msg("spreading shards across workers")
+
+	return h.executeBatchItems(ctx, requestID, batch, workers, standby), nil
+}
+
+// reduceRequestID returns the request ID used for the reduce function execution.
+func reduceRequestID(requestID string) string {
+	return fmt.Sprintf("%s-reduce", requestID)
+}
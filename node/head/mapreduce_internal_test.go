@@ -0,0 +1,192 @@
+package head
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ExecuteMapReduce(t *testing.T) {
+
+	const (
+		mapFunction    = "map-function"
+		reduceFunction = "reduce-function"
+	)
+
+	var (
+		workers = []peer.ID{mocks.GenericPeerIDs[0], mocks.GenericPeerIDs[1]}
+
+		req = execute.MapReduceRequest{
+			FunctionID: mapFunction,
+			Method:     "map.wasm",
+			Config:     execute.Config{NodeCount: len(workers)},
+			Input:      execute.MapInput{Stdin: "a\nb\nc\nd\n"},
+			Reduce: execute.ReduceFunction{
+				FunctionID: reduceFunction,
+				Method:     "reduce.wasm",
+				Config:     execute.Config{NodeCount: 1},
+			},
+		}
+	)
+
+	// Create head node where workers upper-case their input in the map phase, and echo it in the reduce phase.
+	setup := func(t *testing.T, failing ...peer.ID) (*HeadNode, map[peer.ID][]string) {
+		t.Helper()
+
+		var (
+			lock sync.Mutex
+			sent = make(map[peer.ID][]string)
+		)
+
+		head, err := New(mocks.BaselineNodeCore(t), mocks.BaselineStore(t))
+		require.NoError(t, err)
+
+		respond := func(to peer.ID, wo *request.WorkOrder) {
+
+			lock.Lock()
+			sent[to] = append(sent[to], *wo.Config.Stdin)
+			lock.Unlock()
+
+			stdout := *wo.Config.Stdin
+			if wo.FunctionID == mapFunction {
+				stdout = strings.ToUpper(stdout)
+			}
+
+			res := execute.NodeResult{
+				Result: execute.Result{
+					Code:   codes.OK,
+					Result: execute.RuntimeOutput{Stdout: stdout},
+				},
+			}
+			for _, id := range failing {
+				if id == to {
+					res = execute.NodeResult{Result: execute.Result{Code: codes.Error}}
+				}
+			}
+
+			head.workOrderResponses.Set(peerRequestKey(wo.RequestID, to), res)
+		}
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(_ context.Context, _ string, msg blockless.Message) error {
+
+			rc, ok := any(msg).(*request.RollCall)
+			require.True(t, ok)
+
+			for _, worker := range workers {
+				head.rollCall.add(rc.RequestID, rollCallResponse{
+					From: worker,
+					RollCall: response.RollCall{
+						Code:       codes.Accepted,
+						FunctionID: rc.FunctionID,
+						RequestID:  rc.RequestID,
+					},
+				})
+			}
+
+			return nil
+		}
+		core.SendFunc = func(_ context.Context, to peer.ID, msg blockless.Message) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			respond(to, wo)
+			return nil
+		}
+		core.SendToManyFunc = func(_ context.Context, peers []peer.ID, msg blockless.Message, _ bool) error {
+
+			wo, ok := any(msg).(*request.WorkOrder)
+			require.True(t, ok)
+
+			for _, to := range peers {
+				respond(to, wo)
+			}
+			return nil
+		}
+		head.Core = core
+
+		return head, sent
+	}
+
+	t.Run("nominal case", func(t *testing.T) {
+
+		head, sent := setup(t)
+
+		var saved []string
+		store := mocks.BaselineStore(t)
+		store.SaveExecutionResultFunc = func(_ context.Context, record blockless.ExecutionRecord) error {
+			saved = append(saved, record.RequestID)
+			return nil
+		}
+		head.store = store
+
+		code, id, result, err := head.ExecuteMapReduce(context.Background(), req, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+		require.Equal(t, codes.OK, code)
+
+		// Each worker got a different shard.
+		require.Len(t, result.Shards, len(workers))
+		require.Equal(t, "A\nB\n", result.Shards[0].Result.Result.Result.Stdout)
+		require.Equal(t, "C\nD", result.Shards[1].Result.Result.Result.Stdout)
+
+		require.Len(t, result.Results, 1)
+		for peer, res := range result.Results {
+			require.Contains(t, workers, peer)
+			require.Equal(t, "A\nB\nC\nD\n", res.Result.Result.Stdout)
+		}
+
+		// Reduce function was executed with all shard outputs.
+		var total int
+		for _, inputs := range sent {
+			total += len(inputs)
+		}
+		require.Equal(t, len(workers)+1, total)
+
+		// Reduce execution went through the normal execution lifecycle.
+		_, running := head.executions.Get(reduceRequestID(id))
+		require.False(t, running)
+		require.Contains(t, saved, reduceRequestID(id))
+	})
+	t.Run("failed shard", func(t *testing.T) {
+
+		// No standby peers remain as both workers are selected.
+		head, _ := setup(t, workers[1])
+
+		code, _, result, err := head.ExecuteMapReduce(context.Background(), req, "")
+		require.ErrorIs(t, err, blockless.ErrShardFailed)
+		require.Equal(t, codes.PartialContent, code)
+
+		require.Len(t, result.Shards, len(workers))
+		require.Equal(t, codes.OK, result.Shards[0].Result.Code)
+		require.Equal(t, codes.Error, result.Shards[1].Result.Code)
+		require.Empty(t, result.Results)
+	})
+	t.Run("no workers", func(t *testing.T) {
+
+		// No one responds to the roll call.
+		head := createHeadNode(t)
+		head.cfg.RollCallTimeout = 100 * time.Millisecond
+
+		code, _, result, err := head.ExecuteMapReduce(context.Background(), req, "")
+		require.ErrorIs(t, err, blockless.ErrRollCallTimeout)
+		require.Equal(t, codes.Timeout, code)
+		require.Empty(t, result.Shards)
+	})
+}
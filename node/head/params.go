@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/blocklessnetwork/b7s/consensus"
+	"github.com/blocklessnetwork/b7s/models/execute"
 )
 
 const (
@@ -11,12 +12,37 @@ const (
 	DefaultExecutionTimeout        = 20 * time.Second
 	DefaultClusterFormationTimeout = 10 * time.Second
 	DefaultConsensusAlgorithm      = consensus.Raft
+	DefaultResultRetention         = 24 * time.Hour
+	DefaultRollCallWindow          = 1 * time.Second
+	DefaultSelectionStrategy       = execute.SelectFirst
+	DefaultRetryBudget             = 2
+	DefaultIdempotencyWindow       = 10 * time.Minute
+	DefaultMaxConcurrentExecutions = 50
+	DefaultQueueSize               = 200
+	DefaultQueueTimeout            = 1 * time.Minute
 
 	rollCallQueueBufferSize  = 1000
 	executionResultCacheSize = 1000
+	reputationCacheSize      = 1000
+	executionEventBufferSize = 100
 
 	defaultExecutionThreshold = 0.6
 
 	// Timeout for the context used for sending disband request to cluster nodes.
 	consensusClusterSendTimeout = 10 * time.Second
+
+	// Timeout for the context used for sending work order cancellation to peers.
+	cancelWorkOrderSendTimeout = 10 * time.Second
+
+	// Timeout for fetching the function manifest when installing a function from a URI.
+	manifestFetchTimeout = 10 * time.Second
+
+	// How often do we check for expired execution results.
+	resultCleanupInterval = 10 * time.Minute
+
+	// How often do we check for schedules that are due.
+	scheduleCheckInterval = 1 * time.Second
+
+	// How many of the most recent runs are kept in the schedule history.
+	scheduleHistoryLimit = 100
 )
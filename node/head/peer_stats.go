@@ -0,0 +1,89 @@
+package head
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// peerStats keeps track of the work the head node assigned to peers and their advertised capacity.
+type peerStats struct {
+	sync.Mutex
+	m map[peer.ID]*peerStat
+}
+
+type peerStat struct {
+	active   uint                  // Work orders currently in progress.
+	capacity *execute.NodeCapacity // Capacity last advertised by the peer.
+}
+
+func newPeerStats() *peerStats {
+
+	s := peerStats{
+		m: make(map[peer.ID]*peerStat),
+	}
+
+	return &s
+}
+
+// started records that the peers were assigned work.
+func (s *peerStats) started(peers []peer.ID) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, id := range peers {
+		s.get(id).active++
+	}
+}
+
+// release records that the peer is done with the assigned work.
+func (s *peerStats) release(id peer.ID) {
+	s.Lock()
+	defer s.Unlock()
+
+	stat := s.get(id)
+	if stat.active > 0 {
+		stat.active--
+	}
+}
+
+// updateCapacity records the capacity advertised by the peer.
+func (s *peerStats) updateCapacity(id peer.ID, capacity execute.NodeCapacity) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.get(id).capacity = &capacity
+}
+
+// load returns the number of work orders in progress on the peer. If the peer advertised its capacity,
+// its utilization and the average of its CPU and memory pressure are added on top.
+func (s *peerStats) load(id peer.ID) float64 {
+	s.Lock()
+	defer s.Unlock()
+
+	stat, ok := s.m[id]
+	if !ok {
+		return 0
+	}
+
+	load := float64(stat.active)
+	if stat.capacity != nil {
+		load += stat.capacity.Utilization()
+		load += (stat.capacity.CPUPressure + stat.capacity.MemoryPressure) / 2
+	}
+
+	return load
+}
+
+func (s *peerStats) get(id peer.ID) *peerStat {
+
+	stat, ok := s.m[id]
+	if !ok {
+		stat = &peerStat{}
+		s.m[id] = stat
+	}
+
+	return stat
+}
@@ -18,6 +18,8 @@ func (h *HeadNode) process(ctx context.Context, from peer.ID, msg string, payloa
 		return node.HandleMessage(ctx, from, payload, h.processHealthCheck)
 	case blockless.MessageInstallFunctionResponse:
 		return node.HandleMessage(ctx, from, payload, h.processInstallFunctionResponse)
+	case blockless.MessageUninstallFunctionResponse:
+		return node.HandleMessage(ctx, from, payload, h.processUninstallFunctionResponse)
 	case blockless.MessageExecute:
 		return node.HandleMessage(ctx, from, payload, h.processExecute)
 	case blockless.MessageRollCallResponse:
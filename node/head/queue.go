@@ -45,7 +45,8 @@ func (q *rollCallQueue) create(reqID string) {
 	q.m[reqID] = make(chan rollCallResponse, q.size)
 }
 
-// add records a new response to a roll call.
+// add records a new response to a roll call. If the queue for the request is full, the response is dropped,
+// since no one is reading the responses anymore.
 func (q *rollCallQueue) add(id string, res rollCallResponse) {
 	q.Lock()
 	defer q.Unlock()
@@ -55,7 +56,10 @@ func (q *rollCallQueue) add(id string, res rollCallResponse) {
 		return
 	}
 
-	q.m[id] <- res
+	select {
+	case q.m[id] <- res:
+	default:
+	}
 }
 
 // exists returns true if a given request ID exists in the roll call map.
@@ -75,6 +75,25 @@ func TestRollCallQueue(t *testing.T) {
 			queue.add(requestID, res)
 		}
 
+		queue.remove(requestID)
+		require.False(t, queue.exists(requestID))
+	})
+	t.Run("adding to a full roll call queue does not block", func(t *testing.T) {
+
+		const (
+			size = 5
+		)
+
+		queue := newQueue(size)
+		queue.create(requestID)
+
+		// Responses over the queue size are dropped.
+		for i := 0; i < 2*size; i++ {
+			queue.add(requestID, res)
+		}
+
+		require.Len(t, queue.responses(requestID), size)
+
 		queue.remove(requestID)
 		require.False(t, queue.exists(requestID))
 	})
@@ -0,0 +1,232 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+)
+
+// workOutcome is the way the work order assigned to a peer turned out.
+type workOutcome int
+
+const (
+	outcomeSucceeded workOutcome = iota + 1
+	outcomeFailed
+	outcomeTimedOut
+)
+
+// reputationTracker keeps track of the reputation of peers. Reputations are persisted in the store
+// and reputations of recently seen peers are cached in memory so they can be cheaply consulted when selecting peers.
+type reputationTracker struct {
+	store blockless.Store
+
+	sync.Mutex
+	cache *simplelru.LRU
+
+	// Dispatch times and measured latencies of work orders, by peer request key.
+	responses *simplelru.LRU
+}
+
+type workOrderTiming struct {
+	sent     time.Time
+	latency  time.Duration
+	measured bool
+}
+
+func newReputationTracker(store blockless.Store) *reputationTracker {
+
+	// Only fails on non-positive size.
+	cache, _ := simplelru.NewLRU(reputationCacheSize, nil)
+	responses, _ := simplelru.NewLRU(executionResultCacheSize, nil)
+
+	t := reputationTracker{
+		store:     store,
+		cache:     cache,
+		responses: responses,
+	}
+
+	return &t
+}
+
+// dispatched records that the work order for the request was sent to the peers.
+func (t *reputationTracker) dispatched(requestID string, peers ...peer.ID) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	for _, id := range peers {
+		t.responses.Add(peerRequestKey(requestID, id), workOrderTiming{sent: now})
+	}
+}
+
+// responded records that the peer returned the result for the request.
+func (t *reputationTracker) responded(requestID string, id peer.ID) {
+	t.Lock()
+	defer t.Unlock()
+
+	key := peerRequestKey(requestID, id)
+	value, ok := t.responses.Peek(key)
+	if !ok {
+		return
+	}
+
+	timing := value.(workOrderTiming)
+	if timing.measured {
+		return
+	}
+
+	timing.latency = time.Since(timing.sent)
+	timing.measured = true
+	t.responses.Add(key, timing)
+}
+
+// recordOutcome records the outcome of the work order for the request, along with the response latency, if measured.
+func (t *reputationTracker) recordOutcome(ctx context.Context, requestID string, id peer.ID, outcome workOutcome) error {
+	t.Lock()
+	defer t.Unlock()
+
+	var latency time.Duration
+	key := peerRequestKey(requestID, id)
+	value, ok := t.responses.Peek(key)
+	if ok {
+		t.responses.Remove(key)
+
+		timing := value.(workOrderTiming)
+		if timing.measured {
+			latency = timing.latency
+		}
+	}
+
+	return t.update(ctx, id, func(rep *blockless.PeerReputation) {
+
+		switch outcome {
+		case outcomeSucceeded:
+			rep.Succeeded++
+		case outcomeFailed:
+			rep.Failed++
+		case outcomeTimedOut:
+			rep.TimedOut++
+		}
+
+		if latency > 0 {
+			// Running average of the response latency.
+			total := rep.AverageLatency*time.Duration(rep.MeasuredResponses) + latency
+			rep.MeasuredResponses++
+			rep.AverageLatency = total / time.Duration(rep.MeasuredResponses)
+		}
+	})
+}
+
+// recordAgreement records which peers returned results agreeing with the majority, and which did not.
+func (t *reputationTracker) recordAgreement(ctx context.Context, agreed []peer.ID, disagreed []peer.ID) error {
+	t.Lock()
+	defer t.Unlock()
+
+	var errs []error
+	for _, id := range agreed {
+		err := t.update(ctx, id, func(rep *blockless.PeerReputation) {
+			rep.Agreed++
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, id := range disagreed {
+		err := t.update(ctx, id, func(rep *blockless.PeerReputation) {
+			rep.Disagreed++
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// score returns the reputation score of the peer.
+func (t *reputationTracker) score(id peer.ID) float64 {
+	t.Lock()
+	defer t.Unlock()
+
+	rep, err := t.lookup(context.Background(), id)
+	if err != nil {
+		// Peers we know nothing about get a neutral score.
+		return blockless.PeerReputation{}.Score()
+	}
+
+	return rep.Score()
+}
+
+// update applies the change to the reputation of the peer and persists it.
+// Updated reputation is cached even if it could not be persisted.
+func (t *reputationTracker) update(ctx context.Context, id peer.ID, change func(*blockless.PeerReputation)) error {
+
+	rep, err := t.lookup(ctx, id)
+	if err != nil {
+		rep = blockless.PeerReputation{Peer: id}
+	}
+
+	change(&rep)
+	rep.UpdatedAt = time.Now().UTC()
+
+	t.cache.Add(id, rep)
+
+	err = t.store.SaveReputation(ctx, rep)
+	if err != nil {
+		return fmt.Errorf("could not save peer reputation (peer: %s): %w", id, err)
+	}
+
+	return nil
+}
+
+// lookup returns the reputation of the peer, either from the cache or from the store.
+// Peers without a stored reputation get an empty one.
+func (t *reputationTracker) lookup(ctx context.Context, id peer.ID) (blockless.PeerReputation, error) {
+
+	cached, ok := t.cache.Get(id)
+	if ok {
+		return cached.(blockless.PeerReputation), nil
+	}
+
+	rep, err := t.store.RetrieveReputation(ctx, id)
+	if errors.Is(err, blockless.ErrNotFound) {
+		rep = blockless.PeerReputation{}
+	} else if err != nil {
+		return blockless.PeerReputation{}, fmt.Errorf("could not retrieve peer reputation (peer: %s): %w", id, err)
+	}
+
+	rep.Peer = id
+	t.cache.Add(id, rep)
+
+	return rep, nil
+}
+
+// PeerReputation returns the reputation of the peer.
+func (h *HeadNode) PeerReputation(ctx context.Context, id peer.ID) (blockless.PeerReputation, error) {
+
+	rep, err := h.store.RetrieveReputation(ctx, id)
+	if err != nil {
+		return blockless.PeerReputation{}, fmt.Errorf("could not retrieve peer reputation: %w", err)
+	}
+
+	return rep, nil
+}
+
+// PeerReputations returns reputations of all peers the head node assigned work to.
+func (h *HeadNode) PeerReputations(ctx context.Context) ([]blockless.PeerReputation, error) {
+
+	reps, err := h.store.RetrieveReputations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve peer reputations: %w", err)
+	}
+
+	return reps, nil
+}
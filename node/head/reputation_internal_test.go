@@ -0,0 +1,223 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ReputationTracker(t *testing.T) {
+
+	var (
+		ctx       = context.Background()
+		requestID = mocks.GenericUUID.String()
+		id        = mocks.GenericPeerIDs[0]
+	)
+
+	t.Run("outcomes are persisted", func(t *testing.T) {
+
+		store := mocks.BaselineStore(t)
+		store.RetrieveReputationFunc = func(context.Context, peer.ID) (blockless.PeerReputation, error) {
+			return blockless.PeerReputation{}, blockless.ErrNotFound
+		}
+
+		var saved []blockless.PeerReputation
+		store.SaveReputationFunc = func(_ context.Context, rep blockless.PeerReputation) error {
+			saved = append(saved, rep)
+			return nil
+		}
+
+		tracker := newReputationTracker(store)
+
+		tracker.dispatched(requestID, id)
+		time.Sleep(10 * time.Millisecond)
+		tracker.responded(requestID, id)
+
+		require.NoError(t, tracker.recordOutcome(ctx, requestID, id, outcomeSucceeded))
+		require.NoError(t, tracker.recordOutcome(ctx, requestID, id, outcomeFailed))
+		require.NoError(t, tracker.recordOutcome(ctx, requestID, id, outcomeTimedOut))
+		require.NoError(t, tracker.recordAgreement(ctx, []peer.ID{id}, nil))
+
+		require.Len(t, saved, 4)
+
+		rep := saved[len(saved)-1]
+		require.Equal(t, id, rep.Peer)
+		require.Equal(t, uint64(1), rep.Succeeded)
+		require.Equal(t, uint64(1), rep.Failed)
+		require.Equal(t, uint64(1), rep.TimedOut)
+		require.Equal(t, uint64(1), rep.Agreed)
+		require.Zero(t, rep.Disagreed)
+
+		// Latency is measured only for the response that was received.
+		require.Equal(t, uint64(1), rep.MeasuredResponses)
+		require.GreaterOrEqual(t, rep.AverageLatency, 10*time.Millisecond)
+		require.False(t, rep.UpdatedAt.IsZero())
+	})
+	t.Run("stored reputation is picked up", func(t *testing.T) {
+
+		stored := mocks.GenericPeerReputation
+		stored.Peer = id
+
+		store := mocks.BaselineStore(t)
+		store.RetrieveReputationFunc = func(context.Context, peer.ID) (blockless.PeerReputation, error) {
+			return stored, nil
+		}
+
+		tracker := newReputationTracker(store)
+		require.Equal(t, stored.Score(), tracker.score(id))
+
+		require.NoError(t, tracker.recordAgreement(ctx, nil, []peer.ID{id}))
+		require.Less(t, tracker.score(id), stored.Score())
+	})
+	t.Run("unknown peers get a neutral score", func(t *testing.T) {
+
+		store := mocks.BaselineStore(t)
+		store.RetrieveReputationFunc = func(context.Context, peer.ID) (blockless.PeerReputation, error) {
+			return blockless.PeerReputation{}, mocks.GenericError
+		}
+
+		tracker := newReputationTracker(store)
+		require.Equal(t, blockless.PeerReputation{}.Score(), tracker.score(id))
+	})
+	t.Run("store failure is reported", func(t *testing.T) {
+
+		store := mocks.BaselineStore(t)
+		store.SaveReputationFunc = func(context.Context, blockless.PeerReputation) error {
+			return mocks.GenericError
+		}
+
+		tracker := newReputationTracker(store)
+		require.Error(t, tracker.recordOutcome(ctx, requestID, id, outcomeSucceeded))
+	})
+	t.Run("slower peers get a lower score", func(t *testing.T) {
+
+		fast := mocks.GenericPeerReputation
+		slow := mocks.GenericPeerReputation
+		slow.AverageLatency = 10 * fast.AverageLatency
+
+		require.Less(t, slow.Score(), fast.Score())
+	})
+}
+
+func TestHead_MajorityAgreement(t *testing.T) {
+
+	peers := mocks.GenericPeerIDs[:4]
+
+	result := func(stdout string) execute.NodeResult {
+		res := execute.NodeResult{Result: mocks.GenericExecutionResult}
+		res.Code = codes.OK
+		res.Result.Result.Stdout = stdout
+		return res
+	}
+
+	t.Run("majority result", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			peers[0]: result("a"),
+			peers[1]: result("a"),
+			peers[2]: result("a"),
+			peers[3]: result("b"),
+		}
+
+		agreed, disagreed := majorityAgreement(execute.ResultAggregation{}, results)
+		require.ElementsMatch(t, peers[:3], agreed)
+		require.Equal(t, []peer.ID{peers[3]}, disagreed)
+	})
+	t.Run("no majority", func(t *testing.T) {
+
+		results := execute.ResultMap{
+			peers[0]: result("a"),
+			peers[1]: result("a"),
+			peers[2]: result("b"),
+			peers[3]: result("b"),
+		}
+
+		agreed, disagreed := majorityAgreement(execute.ResultAggregation{}, results)
+		require.Empty(t, agreed)
+		require.Empty(t, disagreed)
+	})
+	t.Run("single result", func(t *testing.T) {
+
+		agreed, disagreed := majorityAgreement(execute.ResultAggregation{}, execute.ResultMap{peers[0]: result("a")})
+		require.Empty(t, agreed)
+		require.Empty(t, disagreed)
+	})
+	t.Run("failed results are not counted", func(t *testing.T) {
+
+		failed := result("")
+		failed.Code = codes.Error
+
+		results := execute.ResultMap{
+			peers[0]: result("a"),
+			peers[1]: result("a"),
+			peers[2]: failed,
+			peers[3]: failed,
+		}
+
+		agreed, disagreed := majorityAgreement(execute.ResultAggregation{}, results)
+		require.ElementsMatch(t, peers[:2], agreed)
+		require.Empty(t, disagreed)
+	})
+	t.Run("outputs are compared after normalization", func(t *testing.T) {
+
+		aggregation := execute.ResultAggregation{
+			Normalization: execute.ResultNormalization{
+				TrimWhitespace: true,
+			},
+		}
+
+		results := execute.ResultMap{
+			peers[0]: result("a"),
+			peers[1]: result("a\n"),
+			peers[2]: result("  a"),
+			peers[3]: result("b"),
+		}
+
+		agreed, disagreed := majorityAgreement(aggregation, results)
+		require.ElementsMatch(t, peers[:3], agreed)
+		require.Equal(t, []peer.ID{peers[3]}, disagreed)
+	})
+}
+
+func TestHead_RecordPeerOutcomes_Normalization(t *testing.T) {
+
+	var (
+		requestID = mocks.GenericUUID.String()
+		peers     = mocks.GenericPeerIDs[:3]
+		outputs   = []string{"result", "result", "result\n"}
+
+		aggregation = execute.ResultAggregation{
+			Normalization: execute.ResultNormalization{
+				TrimWhitespace: true,
+			},
+		}
+	)
+
+	head := createHeadNode(t)
+
+	results := make(execute.ResultMap)
+	before := make(map[peer.ID]float64)
+	for i, id := range peers {
+
+		res := execute.NodeResult{Result: mocks.GenericExecutionResult}
+		res.Code = codes.OK
+		res.Result.Result.Stdout = outputs[i]
+		results[id] = res
+
+		before[id] = head.reputation.score(id)
+	}
+
+	head.recordPeerOutcomes(context.Background(), requestID, aggregation, peers, results, false)
+
+	for _, id := range peers {
+		require.GreaterOrEqual(t, head.reputation.score(id), before[id])
+	}
+}
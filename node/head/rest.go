@@ -1,10 +1,15 @@
 package head
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/blocklessnetwork/b7s/fstore"
 	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/execute"
@@ -14,31 +19,149 @@ import (
 // ExecuteFunction can be used to start function execution. At the moment this is used by the API server to start execution on the head node.
 func (h *HeadNode) ExecuteFunction(ctx context.Context, req execute.Request, subgroup string) (codes.Code, string, execute.ResultMap, execute.Cluster, error) {
 
-	requestID := newRequestID()
-
-	code, results, cluster, err := h.execute(ctx, requestID, request.Execute{Request: req})
+	requestID, code, results, cluster, err := h.executeOnce(ctx, request.Execute{Request: req})
+	if errors.Is(err, blockless.ErrIdempotencyKeyConflict) ||
+		errors.Is(err, blockless.ErrExecutionQueueFull) ||
+		errors.Is(err, blockless.ErrExecutionQueueTimeout) {
+		return code, "", nil, execute.Cluster{}, err
+	}
 	if err != nil {
 		h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
 	}
 
+	// Let the caller know why the execution failed, if it's a reason worth communicating.
+	if executionFailureMessage(err) != "" {
+		return code, requestID, results, cluster, err
+	}
+
 	return code, requestID, results, cluster, nil
 }
 
-// ExecutionResult fetches the execution result from the node cache.
-func (h *HeadNode) ExecutionResult(id string) (execute.ResultMap, bool) {
-	// TBD: Head node currently does not cache results.
-	return nil, false
+// ExecuteFunctionAsync starts function execution in the background and returns the request ID immediately.
+// Progress can be tracked using `ExecutionStatus` and the outcome retrieved using `ExecutionResult`.
+func (h *HeadNode) ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string) (string, error) {
+
+	requestID, existing, err := h.claimExecution(req)
+	if err != nil {
+		return "", err
+	}
+
+	// Execution with the same idempotency key already started.
+	if existing != nil {
+		return requestID, nil
+	}
+
+	ticket, err := h.admitExecution(requestID, req)
+	if err != nil {
+		return "", err
+	}
+
+	h.startExecution(ctx, requestID, request.Execute{Request: req}, ticket)
+
+	return requestID, nil
 }
 
-// PublishFunctionInstall publishes a function install message.
-func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error {
+// ExecuteFunctionStream starts function execution in the background and returns a channel on which the execution progress is reported.
+// The channel is closed after the final event is sent, or when the context is canceled.
+func (h *HeadNode) ExecuteFunctionStream(ctx context.Context, req execute.Request, subgroup string) (string, <-chan execute.Event, error) {
+
+	requestID, existing, err := h.claimExecution(req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var ticket *admissionTicket
+	if existing == nil {
+		ticket, err = h.admitExecution(requestID, req)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	// Subscribe before the execution starts so no events are missed.
+	events, unsubscribe := h.events.subscribe(requestID)
+
+	// Execution with the same idempotency key already started. If it's done, there will be no more events so we replay the outcome.
+	if existing != nil && !existing.running() {
+		unsubscribe()
+		return h.replayExecution(ctx, requestID)
+	}
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+
+	if existing == nil {
+		h.startExecution(ctx, requestID, request.Execute{Request: req}, ticket)
+	}
+
+	return requestID, events, nil
+}
+
+// replayExecution returns a channel with the final events of an execution that is already done.
+func (h *HeadNode) replayExecution(ctx context.Context, requestID string) (string, <-chan execute.Event, error) {
+
+	record, err := h.executionResult(ctx, requestID)
+	if err != nil {
+		return "", nil, fmt.Errorf("could not retrieve result of earlier execution: %w", err)
+	}
+
+	events := make(chan execute.Event, 2)
+	events <- execute.Event{
+		Type:      execute.EventState,
+		RequestID: requestID,
+		State:     finalExecutionState(record.Code),
+	}
+	events <- execute.Event{
+		Type:      execute.EventDone,
+		RequestID: requestID,
+		Code:      record.Code,
+		Results:   record.Results,
+		Cluster:   record.Cluster,
+		Message:   record.Message,
+	}
+	close(events)
+
+	return requestID, events, nil
+}
+
+// startExecution runs the execution in the background.
+func (h *HeadNode) startExecution(ctx context.Context, requestID string, req request.Execute, ticket *admissionTicket) {
+
+	// Set the initial state now so the request can be looked up as soon as we return.
+	h.setExecutionState(requestID, execute.StateQueued)
+
+	// Execution should outlive the request that started it.
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		_, _, _, err := h.runExecution(ctx, requestID, req, ticket)
+		if err != nil {
+			h.Log().Error().Str("request", requestID).Err(err).Msg("execution failed")
+		}
+	}()
+}
+
+// ExecutionResult fetches the persisted result of a past execution.
+func (h *HeadNode) ExecutionResult(ctx context.Context, id string) (blockless.ExecutionRecord, error) {
+	return h.executionResult(ctx, id)
+}
+
+// ExecutionStatus returns the current state of the execution request.
+func (h *HeadNode) ExecutionStatus(ctx context.Context, id string) (execute.State, error) {
+	return h.executionStatus(ctx, id)
+}
+
+// PublishFunctionInstall publishes a function install message. Returned is the install job tracking the responses of the workers.
+func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) (blockless.FunctionJob, error) {
 
 	var req request.InstallFunction
 	if uri != "" {
 		var err error
-		req, err = createInstallMessageFromURI(uri)
+		req, err = h.createInstallMessageFromURI(ctx, uri)
 		if err != nil {
-			return fmt.Errorf("could not create install message from URI: %W", err)
+			return blockless.FunctionJob{}, fmt.Errorf("could not create install message from URI: %w", err)
 		}
 	} else {
 		req = createInstallMessageFromCID(cid)
@@ -48,25 +171,82 @@ func (h *HeadNode) PublishFunctionInstall(ctx context.Context, uri string, cid s
 		subgroup = blockless.DefaultTopic
 	}
 
-	h.Log().Debug().Str("subgroup", subgroup).Str("url", req.ManifestURL).Str("cid", req.CID).Msg("publishing function install message")
+	// Start tracking the job before publishing so no responses are missed.
+	job := h.installJobs.start(req.CID, req.ManifestURL, subgroup)
+
+	h.Log().Debug().Str("job", job.ID).Str("subgroup", subgroup).Str("url", req.ManifestURL).Str("cid", req.CID).Msg("publishing function install message")
+
+	err := h.PublishToTopic(ctx, subgroup, &req)
+	if err != nil {
+		h.installJobs.discard(job)
+		return blockless.FunctionJob{}, fmt.Errorf("could not publish message: %w", err)
+	}
+
+	return job, nil
+}
+
+// InstallStatus returns the most recent install job for the function, along with the install outcomes reported by the workers.
+func (h *HeadNode) InstallStatus(ctx context.Context, cid string) (blockless.FunctionJob, error) {
+
+	job, ok := h.installJobs.get(cid)
+	if !ok {
+		return blockless.FunctionJob{}, blockless.ErrNotFound
+	}
+
+	return job, nil
+}
+
+// PublishFunctionUninstall publishes a function uninstall message. Returned is the uninstall job tracking the responses of the workers.
+func (h *HeadNode) PublishFunctionUninstall(ctx context.Context, cid string, subgroup string) (blockless.FunctionJob, error) {
+
+	if subgroup == "" {
+		subgroup = blockless.DefaultTopic
+	}
+
+	req := request.UninstallFunction{
+		CID: cid,
+	}
+
+	// Start tracking the job before publishing so no responses are missed.
+	job := h.uninstallJobs.start(cid, "", subgroup)
+
+	h.Log().Debug().Str("job", job.ID).Str("subgroup", subgroup).Str("cid", cid).Msg("publishing function uninstall message")
 
 	err := h.PublishToTopic(ctx, subgroup, &req)
 	if err != nil {
-		return fmt.Errorf("could not publish message: %w", err)
+		h.uninstallJobs.discard(job)
+		return blockless.FunctionJob{}, fmt.Errorf("could not publish message: %w", err)
+	}
+
+	return job, nil
+}
+
+// UninstallStatus returns the most recent uninstall job for the function, along with the uninstall outcomes reported by the workers.
+func (h *HeadNode) UninstallStatus(ctx context.Context, cid string) (blockless.FunctionJob, error) {
+
+	job, ok := h.uninstallJobs.get(cid)
+	if !ok {
+		return blockless.FunctionJob{}, blockless.ErrNotFound
 	}
 
-	return nil
+	return job, nil
 }
 
 // createInstallMessageFromURI creates a MsgInstallFunction from the given URI.
-// CID is calculated as a SHA-256 hash of the URI.
-func createInstallMessageFromURI(uri string) (request.InstallFunction, error) {
+// CID is the CID of the function archive described by the manifest found at the URI. If the manifest
+// does not specify the archive checksum, the CID is calculated as a SHA-256 hash of the URI.
+func (h *HeadNode) createInstallMessageFromURI(ctx context.Context, uri string) (request.InstallFunction, error) {
 
-	cid, err := deriveCIDFromURI(uri)
+	cid, err := h.resolveFunctionCID(ctx, uri)
 	if err != nil {
 		return request.InstallFunction{}, fmt.Errorf("could not determine cid: %w", err)
 	}
 
+	if cid == "" {
+		h.Log().Warn().Str("url", uri).Msg("function manifest does not specify the archive checksum, using URI hash as CID")
+		cid = deriveCIDFromURI(uri)
+	}
+
 	msg := request.InstallFunction{
 		ManifestURL: uri,
 		CID:         cid,
@@ -86,18 +266,50 @@ func createInstallMessageFromCID(cid string) request.InstallFunction {
 	return req
 }
 
-func deriveCIDFromURI(uri string) (string, error) {
+// resolveFunctionCID fetches the function manifest from the URI and returns the CID of the function archive it describes.
+// Different URIs serving the same function archive resolve to the same CID. If the manifest does not specify
+// the archive checksum, an empty CID is returned.
+func (h *HeadNode) resolveFunctionCID(ctx context.Context, uri string) (string, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return "", fmt.Errorf("could not create request: %w", err)
+	}
 
-	h := sha256.New()
-	_, err := h.Write([]byte(uri))
+	res, err := h.http.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("could not calculate hash: %w", err)
+		return "", fmt.Errorf("could not fetch manifest (url: %s): %w", uri, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not fetch manifest (url: %s): unexpected status: %s", uri, res.Status)
+	}
+
+	var manifest blockless.FunctionManifest
+	err = json.NewDecoder(res.Body).Decode(&manifest)
+	if err != nil {
+		return "", fmt.Errorf("could not decode manifest (url: %s): %w", uri, err)
+	}
+
+	// Legacy manifests have the checksum in the runtime info.
+	checksum := cmp.Or(manifest.Deployment.Checksum, manifest.Runtime.Checksum)
+	if checksum == "" {
+		return "", nil
+	}
+
+	cid, err := fstore.ChecksumCID(checksum)
+	if err != nil {
+		return "", fmt.Errorf("could not determine function CID: %w", err)
 	}
-	cid := fmt.Sprintf("%x", h.Sum(nil))
 
 	return cid, nil
 }
 
+func deriveCIDFromURI(uri string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(uri)))
+}
+
 func manifestURLFromCID(cid string) string {
 	return fmt.Sprintf("https://%s.ipfs.w3s.link/manifest.json", cid)
 }
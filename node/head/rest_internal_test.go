@@ -0,0 +1,96 @@
+package head
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/api"
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ExecuteFunction_QueueTimeout(t *testing.T) {
+
+	head, err := New(
+		mocks.BaselineNodeCore(t),
+		mocks.BaselineStore(t),
+		MaxConcurrentExecutions(1),
+		QueueSize(1),
+		QueueTimeout(20*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// Occupy the only execution slot so the next execution has to wait for its turn.
+	_, err = head.admission.enqueue(0)
+	require.NoError(t, err)
+
+	t.Run("head node returns queue timeout", func(t *testing.T) {
+
+		_, _, _, _, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.ErrorIs(t, err, blockless.ErrExecutionQueueTimeout)
+	})
+	t.Run("api responds with service unavailable", func(t *testing.T) {
+
+		payload, err := json.Marshal(api.ExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/functions/execute", bytes.NewReader(payload))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		err = api.New(mocks.NoopLogger, head).ExecuteFunction(echo.New().NewContext(req, rec))
+		require.Error(t, err)
+
+		echoErr, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusServiceUnavailable, echoErr.Code)
+		require.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+	})
+}
+
+func TestHead_ExecuteFunction_FailureReason(t *testing.T) {
+
+	head := createHeadNode(t)
+	head.cfg.RollCallTimeout = 20 * time.Millisecond
+
+	t.Run("head node returns failure reason", func(t *testing.T) {
+
+		code, requestID, _, _, err := head.ExecuteFunction(context.Background(), mocks.GenericExecutionRequest, "")
+		require.ErrorIs(t, err, blockless.ErrRollCallTimeout)
+		require.Equal(t, codes.Timeout, code)
+		require.NotEmpty(t, requestID)
+	})
+	t.Run("api communicates failure reason", func(t *testing.T) {
+
+		payload, err := json.Marshal(api.ExecutionRequest{
+			FunctionId: mocks.GenericExecutionRequest.FunctionID,
+			Method:     mocks.GenericExecutionRequest.Method,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/functions/execute", bytes.NewReader(payload))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		err = api.New(mocks.NoopLogger, head).ExecuteFunction(echo.New().NewContext(req, rec))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Result().StatusCode)
+
+		var res api.ExecutionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, string(codes.Timeout), res.Code)
+		require.Contains(t, res.Message, blockless.ErrRollCallTimeout.Error())
+	})
+}
@@ -0,0 +1,167 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// runExecution executes the request and persists its outcome once it's done.
+// The execution first waits for its turn in the admission queue, using the given ticket. Executions without a ticket
+// are part of an already admitted request and start straight away.
+func (h *HeadNode) runExecution(ctx context.Context, requestID string, req request.Execute, ticket *admissionTicket) (codes.Code, execute.ResultMap, execute.Cluster, error) {
+
+	startedAt := time.Now()
+
+	// Keep track of the execution so it can be canceled.
+	exctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	h.cancels.Set(requestID, cancel)
+	code, results, cluster, err := h.executeWhenAdmitted(exctx, requestID, req, ticket)
+	h.cancels.Delete(requestID)
+
+	h.saveExecutionResult(ctx, blockless.ExecutionRecord{
+		RequestID:   requestID,
+		FunctionID:  req.FunctionID,
+		Code:        code,
+		Results:     results,
+		Cluster:     cluster,
+		Aggregation: req.Config.ResultAggregation,
+		Message:     executionFailureMessage(err),
+		StartedAt:   startedAt,
+		CompletedAt: time.Now(),
+	})
+
+	// Execution is done - from now on its state is determined by the stored result.
+	h.executions.Delete(requestID)
+
+	// Repeated submissions can now get the stored result.
+	if req.IdempotencyKey != "" {
+		h.idempotency.complete(req.IdempotencyKey, requestID, time.Now())
+	}
+
+	h.publishEvent(execute.Event{
+		Type:      execute.EventState,
+		RequestID: requestID,
+		State:     finalExecutionState(code),
+	})
+	h.publishEvent(execute.Event{
+		Type:      execute.EventDone,
+		RequestID: requestID,
+		Code:      code,
+		Results:   results,
+		Cluster:   cluster,
+		Message:   executionFailureMessage(err),
+	})
+	h.events.close(requestID)
+
+	return code, results, cluster, err
+}
+
+// saveExecutionResult persists the outcome of an execution so it can be retrieved later.
+func (h *HeadNode) saveExecutionResult(ctx context.Context, record blockless.ExecutionRecord) {
+
+	err := h.store.SaveExecutionResult(ctx, record)
+	if err != nil {
+		h.Log().Error().Err(err).Str("request", record.RequestID).Msg("could not save execution result")
+		return
+	}
+
+	h.Log().Debug().Str("request", record.RequestID).Msg("execution result saved")
+}
+
+// executionResult retrieves the execution result for the given request. Expired results are treated as missing.
+func (h *HeadNode) executionResult(ctx context.Context, requestID string) (blockless.ExecutionRecord, error) {
+
+	record, err := h.store.RetrieveExecutionResult(ctx, requestID)
+	if err != nil {
+		return blockless.ExecutionRecord{}, fmt.Errorf("could not retrieve execution result: %w", err)
+	}
+
+	if h.resultExpired(record, time.Now()) {
+		return blockless.ExecutionRecord{}, blockless.ErrNotFound
+	}
+
+	return record, nil
+}
+
+func (h *HeadNode) resultExpired(record blockless.ExecutionRecord, now time.Time) bool {
+
+	if h.cfg.ResultRetention == 0 {
+		return false
+	}
+
+	return now.Sub(record.CompletedAt) > h.cfg.ResultRetention
+}
+
+// removeExpiredResults removes all execution results that are past the retention window.
+func (h *HeadNode) removeExpiredResults(ctx context.Context) error {
+
+	records, err := h.store.RetrieveExecutionResults(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve execution results: %w", err)
+	}
+
+	now := time.Now()
+	for _, record := range records {
+
+		if !h.resultExpired(record, now) {
+			continue
+		}
+
+		err = h.store.RemoveExecutionResult(ctx, record.RequestID)
+		if err != nil {
+			return fmt.Errorf("could not remove execution result (request: %s): %w", record.RequestID, err)
+		}
+	}
+
+	return nil
+}
+
+func (h *HeadNode) runResultCleanupLoop(ctx context.Context) {
+
+	// Results never expire - nothing to do.
+	if h.cfg.ResultRetention == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(resultCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := h.removeExpiredResults(ctx)
+			if err != nil {
+				h.Log().Error().Err(err).Msg("execution result cleanup failed")
+				continue
+			}
+
+			h.Log().Debug().Msg("execution result cleanup ok")
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// executionFailureMessage returns the reason for execution failure that should be communicated to the user, if any.
+func executionFailureMessage(err error) string {
+
+	if errors.Is(err, blockless.ErrRollCallTimeout) ||
+		errors.Is(err, blockless.ErrExecutionNotEnoughNodes) ||
+		errors.Is(err, blockless.ErrNotEnoughMatchingResults) ||
+		errors.Is(err, blockless.ErrExecutionCanceled) ||
+		errors.Is(err, blockless.ErrExecutionQueueTimeout) {
+		return err.Error()
+	}
+
+	return ""
+}
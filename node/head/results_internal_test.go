@@ -0,0 +1,60 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/store"
+	"github.com/blocklessnetwork/b7s/store/codec"
+	"github.com/blocklessnetwork/b7s/testing/helpers"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ExecutionResultRetention(t *testing.T) {
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	var (
+		ctx       = context.Background()
+		retention = time.Hour
+		store     = store.New(db, codec.NewJSONCodec())
+	)
+
+	head, err := New(mocks.BaselineNodeCore(t), store, ResultRetention(retention))
+	require.NoError(t, err)
+
+	fresh := mocks.GenericExecutionRecord
+	fresh.RequestID = "fresh-request"
+	fresh.CompletedAt = time.Now()
+
+	expired := mocks.GenericExecutionRecord
+	expired.RequestID = "expired-request"
+	expired.CompletedAt = time.Now().Add(-2 * retention)
+
+	head.saveExecutionResult(ctx, fresh)
+	head.saveExecutionResult(ctx, expired)
+
+	t.Run("fresh result is returned", func(t *testing.T) {
+		record, err := head.ExecutionResult(ctx, fresh.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, fresh.RequestID, record.RequestID)
+	})
+	t.Run("expired result is not returned", func(t *testing.T) {
+		_, err := head.ExecutionResult(ctx, expired.RequestID)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+	t.Run("expired results are removed", func(t *testing.T) {
+		err := head.removeExpiredResults(ctx)
+		require.NoError(t, err)
+
+		records, err := store.RetrieveExecutionResults(ctx)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, fresh.RequestID, records[0].RequestID)
+	})
+}
@@ -3,7 +3,9 @@ package head
 import (
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -13,16 +15,20 @@ import (
 	"github.com/blocklessnetwork/b7s/consensus/pbft"
 	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
 	"github.com/blocklessnetwork/b7s/models/request"
 	"github.com/blocklessnetwork/b7s/models/response"
 )
 
+// executeRollCall publishes a roll call and chooses peers to execute the request among the ones that report.
+// Peers that reported but were not chosen make up the standby pool. Roll call stays open after this function returns,
+// so that peers reporting late can be used as standby too. Caller should remove the roll call once the execution is done.
 func (h *HeadNode) executeRollCall(
 	ctx context.Context,
 	requestID string,
 	req request.Execute,
 	consensus cons.Type,
-) ([]peer.ID, error) {
+) ([]peer.ID, *standbyPool, error) {
 
 	// Create a logger with relevant context.
 	log := h.Log().With().
@@ -35,11 +41,10 @@ func (h *HeadNode) executeRollCall(
 	log.Info().Msg("performing roll call for request")
 
 	h.rollCall.create(requestID)
-	defer h.rollCall.remove(requestID)
 
 	err := h.publishRollCall(ctx, req.RollCall(requestID, consensus), req.Topic)
 	if err != nil {
-		return nil, fmt.Errorf("could not publish roll call: %w", err)
+		return nil, nil, fmt.Errorf("could not publish roll call: %w", err)
 	}
 
 	log.Info().Msg("roll call published")
@@ -52,10 +57,25 @@ func (h *HeadNode) executeRollCall(
 	tctx, cancel := context.WithTimeout(ctx, t)
 	defer cancel()
 
-	nodeCount := req.Config.NodeCount
+	nodeCount := rollCallNodeCount(req.Config.NodeCount)
+	strategy := cmp.Or(req.Config.SelectionStrategy, h.cfg.DefaultSelection)
+
+	log = log.With().Str("selection", string(strategy)).Logger()
+
+	// First-N selection takes peers as soon as they report. Other strategies collect
+	// responses for a while so they have a pool of peers to choose from.
+	windowElapsed := strategy == execute.SelectFirst
+	window := time.NewTimer(h.cfg.RollCallWindow)
+	defer window.Stop()
+
+	// Do we have enough peers to stop waiting for more. Roll call never finishes without peers.
+	enough := func(n int) bool {
+		// -1 means we'll take any peers reporting, so we wait until timeout.
+		return windowElapsed && nodeCount != -1 && n >= 1 && n >= nodeCount
+	}
 
 	// Peers that have reported on roll call.
-	var reportingPeers []peer.ID
+	var candidates []peer.ID
 rollCallResponseLoop:
 	for {
 		// Wait for responses from nodes who want to work on the request.
@@ -63,52 +83,125 @@ rollCallResponseLoop:
 		// Request timed out.
 		case <-tctx.Done():
 
+			// Execution was canceled.
+			if ctx.Err() != nil {
+				return nil, nil, context.Cause(ctx)
+			}
+
 			// -1 means we'll take any peers reporting
-			if len(reportingPeers) >= 1 && nodeCount == -1 {
+			if len(candidates) >= 1 && nodeCount == -1 {
 				log.Info().Msg("enough peers reported for roll call")
 				break rollCallResponseLoop
 			}
 
 			log.Warn().Msg("roll call timed out")
-			return nil, blockless.ErrRollCallTimeout
+			return nil, nil, blockless.ErrRollCallTimeout
 
-		case reply := <-h.rollCall.responses(requestID):
+		case <-window.C:
 
-			// Check if this is the reply we want - shouldn't really happen.
-			if reply.FunctionID != req.FunctionID {
-				log.Info().
-					Stringer("peer", reply.From).
-					Str("function_got", reply.FunctionID).
-					Msg("skipping inadequate roll call response - wrong function")
-				continue
+			windowElapsed = true
+			if enough(len(candidates)) {
+				log.Info().Int("candidates", len(candidates)).Msg("enough peers reported for roll call")
+				break rollCallResponseLoop
 			}
 
-			// Check if we are connected to this peer.
-			// Since we receive responses to roll call via direct messages - should not happen.
-			if !h.Connected(reply.From) {
-				h.Log().Info().
-					Stringer("peer", reply.From).
-					Msg("skipping roll call response from unconnected peer")
+		case reply := <-h.rollCall.responses(requestID):
+
+			err := h.checkRollCallResponse(req.FunctionID, reply)
+			if err != nil {
+				log.Info().Err(err).Stringer("peer", reply.From).Msg("skipping inadequate roll call response")
 				continue
 			}
 
-			log.Info().Stringer("peer", reply.From).Msg("roll called peer chosen for execution")
+			log.Info().Stringer("peer", reply.From).Msg("roll called peer reported for execution")
 
-			reportingPeers = append(reportingPeers, reply.From)
+			candidates = append(candidates, reply.From)
 
-			// -1 means we'll take any peers reporting
-			if len(reportingPeers) >= nodeCount && nodeCount != -1 {
+			h.publishEvent(execute.Event{
+				Type:      execute.EventRollCall,
+				RequestID: requestID,
+				Peer:      reply.From,
+			})
+
+			if enough(len(candidates)) {
 				log.Info().Msg("enough peers reported for roll call")
 				break rollCallResponseLoop
 			}
 		}
 	}
 
+	selector := h.selector(strategy)
+	reportingPeers := selector.Select(nodeCount, candidates)
+
+	// Peers not chosen are kept on standby, in order of preference.
+	var rest []peer.ID
+	for _, id := range candidates {
+		if !slices.Contains(reportingPeers, id) {
+			rest = append(rest, id)
+		}
+	}
+
+	standby := newStandbyPool(
+		reportingPeers,
+		selector.Select(-1, rest),
+		h.rollCall.responses(requestID),
+		func(reply rollCallResponse) bool {
+			return h.checkRollCallResponse(req.FunctionID, reply) == nil
+		},
+	)
+
+	log.Info().
+		Strs("peers", blockless.PeerIDsToStr(reportingPeers)).
+		Int("standby", len(standby.peers)).
+		Msg("roll called peers chosen for execution")
+
+	// Should not happen, but make sure we never proceed without peers.
+	if len(reportingPeers) == 0 {
+		return nil, nil, blockless.ErrRollCallTimeout
+	}
+
 	if consensus == cons.PBFT && len(reportingPeers) < pbft.MinimumReplicaCount {
-		return nil, fmt.Errorf("not enough peers reported for PBFT consensus (have: %v, need: %v)", len(reportingPeers), pbft.MinimumReplicaCount)
+		return nil, nil, fmt.Errorf("not enough peers reported for PBFT consensus (have: %v, need: %v)", len(reportingPeers), pbft.MinimumReplicaCount)
 	}
 
-	return reportingPeers, nil
+	return reportingPeers, standby, nil
+}
+
+// rollCallNodeCount returns the number of peers the roll call should select. Non-positive values mean a single peer,
+// except for -1, which means we'll take any peers reporting.
+func rollCallNodeCount(n int) int {
+
+	if n == -1 {
+		return -1
+	}
+
+	if n <= 0 {
+		return 1
+	}
+
+	return n
+}
+
+// checkRollCallResponse returns an error if the peer that responded to the roll call cannot be used for the execution.
+func (h *HeadNode) checkRollCallResponse(functionID string, reply rollCallResponse) error {
+
+	// Check if this is the reply we want - shouldn't really happen.
+	if reply.FunctionID != functionID {
+		return fmt.Errorf("wrong function (got: %s)", reply.FunctionID)
+	}
+
+	// Check if we are connected to this peer.
+	// Since we receive responses to roll call via direct messages - should not happen.
+	if !h.Connected(reply.From) {
+		return errors.New("peer not connected")
+	}
+
+	// Peer reported but has no room for more work - shouldn't really happen.
+	if reply.Capacity != nil && reply.Capacity.FreeSlots == 0 {
+		return errors.New("peer at capacity")
+	}
+
+	return nil
 }
 
 // publishRollCall will create a roll call request for executing the given function.
@@ -138,12 +231,20 @@ func (h *HeadNode) processRollCallResponse(ctx context.Context, from peer.ID, re
 
 	log.Debug().Msg("processing peer's roll call response")
 
+	// Keep track of the load peers advertise, even if they decline.
+	if res.Capacity != nil {
+		h.peerStats.updateCapacity(from, *res.Capacity)
+	}
+
 	// Check if the response is adequate.
 	if res.Code != codes.Accepted {
 		log.Info().Stringer("code", res.Code).Msg("skipping inadequate roll call response - unwanted code")
 		return nil
 	}
 
+	// Peers only report for roll calls for functions they have installed.
+	h.functions.report(res.FunctionID, from)
+
 	// Check if there's an active roll call already.
 	exists := h.rollCall.exists(res.RequestID)
 	if !exists {
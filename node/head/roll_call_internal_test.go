@@ -0,0 +1,99 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_RollCallNodeCount(t *testing.T) {
+
+	require.Equal(t, -1, rollCallNodeCount(-1))
+	require.Equal(t, 1, rollCallNodeCount(0))
+	require.Equal(t, 1, rollCallNodeCount(-5))
+	require.Equal(t, 3, rollCallNodeCount(3))
+}
+
+func TestHead_ExecuteRollCall_NoNodeCount(t *testing.T) {
+
+	const (
+		window         = 10 * time.Millisecond
+		timeout        = 300 * time.Millisecond
+		successTimeout = 5 * time.Second
+	)
+
+	newRequest := func() request.Execute {
+		req := mocks.GenericExecutionRequest
+		req.Config.NodeCount = 0
+		req.Config.SelectionStrategy = execute.SelectRandom
+
+		return request.Execute{
+			Request: req,
+			Topic:   fmt.Sprintf("topic-%v", rand.Int()),
+		}
+	}
+
+	t.Run("no candidates", func(t *testing.T) {
+		t.Parallel()
+
+		head := createHeadNode(t)
+		head.cfg.RollCallWindow = window
+		head.cfg.RollCallTimeout = timeout
+
+		requestID := newRequestID()
+
+		_, _, err := head.executeRollCall(context.Background(), requestID, newRequest(), 0)
+		require.ErrorIs(t, err, blockless.ErrRollCallTimeout)
+	})
+	t.Run("candidate reports after window", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			requestID = newRequestID()
+			er        = newRequest()
+		)
+
+		head := createHeadNode(t)
+		head.cfg.RollCallWindow = window
+		head.cfg.RollCallTimeout = successTimeout
+
+		core := mocks.BaselineNodeCore(t)
+		core.ConnectedFunc = func(peer.ID) bool {
+			return true
+		}
+		core.PublishToTopicFunc = func(context.Context, string, blockless.Message) error {
+
+			// Reply only once the selection window has passed.
+			go func() {
+				time.Sleep(2 * window)
+				head.rollCall.add(requestID, rollCallResponse{
+					From: mocks.GenericPeerID,
+					RollCall: response.RollCall{
+						Code:       codes.Accepted,
+						FunctionID: er.FunctionID,
+						RequestID:  requestID,
+					},
+				})
+			}()
+
+			return nil
+		}
+		head.Core = core
+
+		peers, _, err := head.executeRollCall(context.Background(), requestID, er, 0)
+		require.NoError(t, err)
+		require.Equal(t, []peer.ID{mocks.GenericPeerID}, peers)
+	})
+}
@@ -0,0 +1,229 @@
+package head
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// CreateSchedule creates a schedule for recurring execution of the request. The request is executed whenever the cron expression is due.
+func (h *HeadNode) CreateSchedule(ctx context.Context, expr string, req execute.Request, subgroup string) (blockless.ScheduleRecord, error) {
+
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return blockless.ScheduleRecord{}, fmt.Errorf("could not parse cron expression: %w", err)
+	}
+
+	now := time.Now().UTC()
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return blockless.ScheduleRecord{}, errors.New("schedule is never due")
+	}
+
+	record := blockless.ScheduleRecord{
+		ID:        newRequestID(),
+		Cron:      expr,
+		Request:   req,
+		Topic:     subgroup,
+		CreatedAt: now,
+		NextRun:   next,
+	}
+
+	err = h.store.SaveSchedule(ctx, record)
+	if err != nil {
+		return blockless.ScheduleRecord{}, fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	h.Log().Info().
+		Str("schedule", record.ID).
+		Str("cron", expr).
+		Str("function", req.FunctionID).
+		Time("next_run", next).
+		Msg("schedule created")
+
+	return record, nil
+}
+
+// Schedule returns the schedule with the given ID, along with its run history.
+func (h *HeadNode) Schedule(ctx context.Context, id string) (blockless.ScheduleRecord, error) {
+
+	record, err := h.store.RetrieveSchedule(ctx, id)
+	if err != nil {
+		return blockless.ScheduleRecord{}, fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	return record, nil
+}
+
+// Schedules returns all schedules.
+func (h *HeadNode) Schedules(ctx context.Context) ([]blockless.ScheduleRecord, error) {
+
+	records, err := h.store.RetrieveSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve schedules: %w", err)
+	}
+
+	return records, nil
+}
+
+// RemoveSchedule removes the schedule with the given ID. Runs already in progress are not affected.
+func (h *HeadNode) RemoveSchedule(ctx context.Context, id string) error {
+
+	h.scheduleLock.Lock()
+	defer h.scheduleLock.Unlock()
+
+	_, err := h.store.RetrieveSchedule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	err = h.store.RemoveSchedule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not remove schedule: %w", err)
+	}
+
+	h.Log().Info().Str("schedule", id).Msg("schedule removed")
+
+	return nil
+}
+
+func (h *HeadNode) runScheduler(ctx context.Context) {
+
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := h.runDueSchedules(ctx, time.Now().UTC())
+			if err != nil {
+				h.Log().Error().Err(err).Msg("could not run due schedules")
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runDueSchedules starts executions for all schedules that are due. Runs missed while the node was down are not made up for.
+func (h *HeadNode) runDueSchedules(ctx context.Context, now time.Time) error {
+
+	h.scheduleLock.Lock()
+	defer h.scheduleLock.Unlock()
+
+	records, err := h.store.RetrieveSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve schedules: %w", err)
+	}
+
+	for _, record := range records {
+
+		// Zero time means the schedule is never due.
+		if record.NextRun.IsZero() || record.NextRun.After(now) {
+			continue
+		}
+
+		log := h.Log().With().Str("schedule", record.ID).Logger()
+
+		schedule, err := cron.ParseStandard(record.Cron)
+		if err != nil {
+			log.Error().Err(err).Str("cron", record.Cron).Msg("could not parse cron expression of the schedule")
+			continue
+		}
+
+		// Determine next run before starting this one, so it's not started twice.
+		record.NextRun = schedule.Next(now)
+		err = h.store.SaveSchedule(ctx, record)
+		if err != nil {
+			log.Error().Err(err).Msg("could not update schedule")
+			continue
+		}
+
+		log.Debug().Time("next_run", record.NextRun).Msg("schedule due, starting execution")
+
+		go h.runSchedule(ctx, record)
+	}
+
+	return nil
+}
+
+// runSchedule executes the request of the schedule and records the outcome in the schedule history.
+func (h *HeadNode) runSchedule(ctx context.Context, record blockless.ScheduleRecord) {
+
+	req := request.Execute{
+		Request: record.Request,
+		Topic:   record.Topic,
+	}
+
+	// Each run is a separate execution, so it should not be deduplicated.
+	req.IdempotencyKey = ""
+
+	requestID := newRequestID()
+	run := blockless.ScheduleRun{
+		RequestID: requestID,
+		StartedAt: time.Now().UTC(),
+	}
+
+	ticket, err := h.admitExecution(requestID, req.Request)
+	if err != nil {
+		run.Code = codes.TooManyRequests
+		run.Message = err.Error()
+	} else {
+		var code codes.Code
+		code, _, _, err = h.runExecution(ctx, requestID, req, ticket)
+
+		run.Code = code
+		run.Message = executionFailureMessage(err)
+	}
+
+	run.CompletedAt = time.Now().UTC()
+
+	log := h.Log().With().Str("schedule", record.ID).Str("request", requestID).Logger()
+	if err != nil {
+		log.Warn().Err(err).Msg("scheduled execution failed")
+	}
+
+	log.Info().Stringer("code", run.Code).Msg("scheduled execution complete")
+
+	err = h.recordScheduleRun(ctx, record.ID, run)
+	if err != nil {
+		log.Error().Err(err).Msg("could not record schedule run")
+	}
+}
+
+// recordScheduleRun adds the run to the schedule history, keeping only the most recent runs.
+func (h *HeadNode) recordScheduleRun(ctx context.Context, id string, run blockless.ScheduleRun) error {
+
+	h.scheduleLock.Lock()
+	defer h.scheduleLock.Unlock()
+
+	record, err := h.store.RetrieveSchedule(ctx, id)
+	// Schedule was removed in the meantime.
+	if errors.Is(err, blockless.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	record.History = append(record.History, run)
+	if len(record.History) > scheduleHistoryLimit {
+		record.History = record.History[len(record.History)-scheduleHistoryLimit:]
+	}
+
+	err = h.store.SaveSchedule(ctx, record)
+	if err != nil {
+		return fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	return nil
+}
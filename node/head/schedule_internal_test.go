@@ -0,0 +1,103 @@
+package head
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/store"
+	"github.com/blocklessnetwork/b7s/store/codec"
+	"github.com/blocklessnetwork/b7s/testing/helpers"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_Schedules(t *testing.T) {
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	var (
+		ctx   = context.Background()
+		store = store.New(db, codec.NewJSONCodec())
+	)
+
+	head, err := New(mocks.BaselineNodeCore(t), store)
+	require.NoError(t, err)
+
+	t.Run("invalid cron expression is rejected", func(t *testing.T) {
+		_, err := head.CreateSchedule(ctx, "* * *", mocks.GenericExecutionRequest, "")
+		require.Error(t, err)
+	})
+	t.Run("schedule that is never due is rejected", func(t *testing.T) {
+		_, err := head.CreateSchedule(ctx, "0 0 30 2 *", mocks.GenericExecutionRequest, "")
+		require.Error(t, err)
+	})
+	t.Run("due schedule is executed and run recorded", func(t *testing.T) {
+
+		record, err := head.CreateSchedule(ctx, "*/5 * * * *", mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+
+		// Schedule is not due yet.
+		err = head.runDueSchedules(ctx, record.NextRun.Add(-time.Second))
+		require.NoError(t, err)
+
+		retrieved, err := head.Schedule(ctx, record.ID)
+		require.NoError(t, err)
+		require.Equal(t, record.NextRun, retrieved.NextRun)
+
+		// Execution context is done so the run finishes straight away.
+		runctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err = head.runDueSchedules(runctx, record.NextRun)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			retrieved, err := head.Schedule(ctx, record.ID)
+			return err == nil && len(retrieved.History) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		retrieved, err = head.Schedule(ctx, record.ID)
+		require.NoError(t, err)
+		require.Equal(t, record.NextRun.Add(5*time.Minute), retrieved.NextRun)
+
+		run := retrieved.History[0]
+		require.NotEqual(t, codes.OK, run.Code)
+
+		// Run outcome is persisted like for any other execution.
+		result, err := head.ExecutionResult(ctx, run.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, run.Code, result.Code)
+
+		err = head.RemoveSchedule(ctx, record.ID)
+		require.NoError(t, err)
+
+		_, err = head.Schedule(ctx, record.ID)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+	t.Run("only most recent runs are kept", func(t *testing.T) {
+
+		record, err := head.CreateSchedule(ctx, "@hourly", mocks.GenericExecutionRequest, "")
+		require.NoError(t, err)
+
+		for i := 0; i < scheduleHistoryLimit+10; i++ {
+			run := blockless.ScheduleRun{RequestID: fmt.Sprint(i)}
+			err = head.recordScheduleRun(ctx, record.ID, run)
+			require.NoError(t, err)
+		}
+
+		retrieved, err := head.Schedule(ctx, record.ID)
+		require.NoError(t, err)
+		require.Len(t, retrieved.History, scheduleHistoryLimit)
+		require.Equal(t, "10", retrieved.History[0].RequestID)
+	})
+	t.Run("removing missing schedule fails", func(t *testing.T) {
+		err := head.RemoveSchedule(ctx, "missing-schedule")
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+}
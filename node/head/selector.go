@@ -0,0 +1,91 @@
+package head
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// Selector chooses which of the peers that reported for the roll call will execute the request.
+// Candidates are given in the order in which they responded. Node count of -1 (or zero) means all candidates should be used.
+type Selector interface {
+	Select(n int, candidates []peer.ID) []peer.ID
+}
+
+// selector returns the selector implementing the given strategy.
+func (h *HeadNode) selector(strategy execute.SelectionStrategy) Selector {
+
+	switch strategy {
+	case execute.SelectRandom:
+		return randomSelector{}
+	case execute.SelectLeastLoaded:
+		return leastLoadedSelector{load: h.peerStats.load}
+	case execute.SelectReputation:
+		return reputationSelector{score: h.reputation.score}
+	default:
+		return firstSelector{}
+	}
+}
+
+// firstSelector chooses the first N peers that reported for the roll call.
+type firstSelector struct{}
+
+func (firstSelector) Select(n int, candidates []peer.ID) []peer.ID {
+	return firstN(n, candidates)
+}
+
+// randomSelector chooses N random peers.
+type randomSelector struct{}
+
+func (randomSelector) Select(n int, candidates []peer.ID) []peer.ID {
+
+	peers := slices.Clone(candidates)
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+
+	return firstN(n, peers)
+}
+
+// leastLoadedSelector chooses N peers with the least amount of work in progress. Ties are broken by response order.
+type leastLoadedSelector struct {
+	load func(peer.ID) float64
+}
+
+func (s leastLoadedSelector) Select(n int, candidates []peer.ID) []peer.ID {
+
+	peers := slices.Clone(candidates)
+	slices.SortStableFunc(peers, func(a, b peer.ID) int {
+		return cmp.Compare(s.load(a), s.load(b))
+	})
+
+	return firstN(n, peers)
+}
+
+// reputationSelector chooses N peers with the highest reputation score. Ties are broken by response order.
+type reputationSelector struct {
+	score func(peer.ID) float64
+}
+
+func (s reputationSelector) Select(n int, candidates []peer.ID) []peer.ID {
+
+	peers := slices.Clone(candidates)
+	slices.SortStableFunc(peers, func(a, b peer.ID) int {
+		return cmp.Compare(s.score(b), s.score(a))
+	})
+
+	return firstN(n, peers)
+}
+
+func firstN(n int, peers []peer.ID) []peer.ID {
+
+	if n <= 0 || n >= len(peers) {
+		return peers
+	}
+
+	return peers[:n]
+}
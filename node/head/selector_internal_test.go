@@ -0,0 +1,76 @@
+package head
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_Selector(t *testing.T) {
+
+	candidates := mocks.GenericPeerIDs[:4]
+
+	t.Run("first selector", func(t *testing.T) {
+		head := createHeadNode(t)
+
+		peers := head.selector(execute.SelectFirst).Select(2, candidates)
+		require.Equal(t, candidates[:2], peers)
+
+		peers = head.selector(execute.SelectFirst).Select(-1, candidates)
+		require.Equal(t, candidates, peers)
+	})
+	t.Run("unspecified strategy selects first peers", func(t *testing.T) {
+		head := createHeadNode(t)
+
+		peers := head.selector("").Select(3, candidates)
+		require.Equal(t, candidates[:3], peers)
+	})
+	t.Run("random selector", func(t *testing.T) {
+		head := createHeadNode(t)
+
+		peers := head.selector(execute.SelectRandom).Select(2, candidates)
+		require.Len(t, peers, 2)
+		require.NotEqual(t, peers[0], peers[1])
+		require.Subset(t, candidates, peers)
+
+		// Candidate list is left intact.
+		require.Equal(t, mocks.GenericPeerIDs[:4], candidates)
+	})
+	t.Run("least loaded selector", func(t *testing.T) {
+		head := createHeadNode(t)
+
+		// First two peers are busy.
+		head.peerStats.started(candidates[:2])
+		head.peerStats.started(candidates[:1])
+
+		peers := head.selector(execute.SelectLeastLoaded).Select(3, candidates)
+		require.Equal(t, []peer.ID{candidates[2], candidates[3], candidates[1]}, peers)
+	})
+	t.Run("least loaded selector uses advertised capacity", func(t *testing.T) {
+		head := createHeadNode(t)
+
+		head.peerStats.updateCapacity(candidates[0], execute.NodeCapacity{FreeSlots: 1, RunningExecutions: 9})
+		head.peerStats.updateCapacity(candidates[1], execute.NodeCapacity{FreeSlots: 9, RunningExecutions: 1, CPUPressure: 0.9, MemoryPressure: 0.9})
+		head.peerStats.updateCapacity(candidates[2], execute.NodeCapacity{FreeSlots: 10})
+
+		peers := head.selector(execute.SelectLeastLoaded).Select(-1, candidates[:3])
+		require.Equal(t, []peer.ID{candidates[2], candidates[0], candidates[1]}, peers)
+	})
+	t.Run("reputation selector", func(t *testing.T) {
+		head := createHeadNode(t)
+
+		// Last peer has a good track record, first one is unreliable.
+		for i := 0; i < 3; i++ {
+			require.NoError(t, head.reputation.recordOutcome(context.Background(), mocks.GenericUUID.String(), candidates[0], outcomeTimedOut))
+			require.NoError(t, head.reputation.recordOutcome(context.Background(), mocks.GenericUUID.String(), candidates[3], outcomeSucceeded))
+		}
+
+		peers := head.selector(execute.SelectReputation).Select(-1, candidates)
+		require.Equal(t, []peer.ID{candidates[3], candidates[1], candidates[2], candidates[0]}, peers)
+	})
+}
@@ -0,0 +1,78 @@
+package head
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// standbyPool holds peers that reported for the roll call but were not chosen for execution.
+// They can take over the work orders of peers that fail. Not safe for concurrent use.
+type standbyPool struct {
+	peers []peer.ID
+
+	// Peers that were already chosen for execution or queued as standby. They are never handed out as standby again.
+	used map[peer.ID]struct{}
+
+	// Roll call responses that arrive after the peers were chosen.
+	responses <-chan rollCallResponse
+	accept    func(rollCallResponse) bool
+}
+
+// newStandbyPool creates a new standby pool. Selected peers are the ones chosen for execution, while the standby peers
+// are used, in order, to replace the selected peers that fail.
+func newStandbyPool(selected []peer.ID, standby []peer.ID, responses <-chan rollCallResponse, accept func(rollCallResponse) bool) *standbyPool {
+
+	p := standbyPool{
+		peers:     standby,
+		used:      make(map[peer.ID]struct{}, len(selected)+len(standby)),
+		responses: responses,
+		accept:    accept,
+	}
+
+	for _, id := range selected {
+		p.used[id] = struct{}{}
+	}
+	for _, id := range standby {
+		p.used[id] = struct{}{}
+	}
+
+	return &p
+}
+
+// next returns the next standby peer. Peers that reported on time are used first, then the ones reporting late.
+func (p *standbyPool) next() (peer.ID, bool) {
+
+	if p == nil {
+		return "", false
+	}
+
+	if len(p.peers) > 0 {
+		id := p.peers[0]
+		p.peers = p.peers[1:]
+		return id, true
+	}
+
+	for {
+		select {
+		case reply, ok := <-p.responses:
+			if !ok {
+				return "", false
+			}
+
+			// Skip peers reporting more than once, as well as peers already used for this execution.
+			_, used := p.used[reply.From]
+			if used || !p.accept(reply) {
+				continue
+			}
+
+			if p.used == nil {
+				p.used = make(map[peer.ID]struct{})
+			}
+			p.used[reply.From] = struct{}{}
+
+			return reply.From, true
+
+		default:
+			return "", false
+		}
+	}
+}
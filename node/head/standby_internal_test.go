@@ -0,0 +1,41 @@
+package head
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_StandbyPool(t *testing.T) {
+
+	var (
+		selected = mocks.GenericPeerIDs[0]
+		standby  = mocks.GenericPeerIDs[1]
+		late     = mocks.GenericPeerIDs[2]
+	)
+
+	responses := make(chan rollCallResponse, 10)
+	accept := func(rollCallResponse) bool { return true }
+
+	pool := newStandbyPool([]peer.ID{selected}, []peer.ID{standby}, responses, accept)
+
+	// Duplicate replies from peers already selected or on standby are ignored, as well as repeated late replies.
+	responses <- rollCallResponse{From: selected}
+	responses <- rollCallResponse{From: standby}
+	responses <- rollCallResponse{From: late}
+	responses <- rollCallResponse{From: late}
+
+	id, ok := pool.next()
+	require.True(t, ok)
+	require.Equal(t, standby, id)
+
+	id, ok = pool.next()
+	require.True(t, ok)
+	require.Equal(t, late, id)
+
+	_, ok = pool.next()
+	require.False(t, ok)
+}
@@ -0,0 +1,56 @@
+package head
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+func (h *HeadNode) setExecutionState(requestID string, state execute.State) {
+
+	current, ok := h.executions.Get(requestID)
+	if ok && current == state {
+		return
+	}
+
+	h.executions.Set(requestID, state)
+	h.Log().Debug().Str("request", requestID).Stringer("state", state).Msg("execution state changed")
+
+	h.publishEvent(execute.Event{
+		Type:      execute.EventState,
+		RequestID: requestID,
+		State:     state,
+	})
+}
+
+// executionStatus returns the state of the execution request. Requests in progress are tracked in memory,
+// while the state of completed requests is derived from the stored execution result.
+func (h *HeadNode) executionStatus(ctx context.Context, requestID string) (execute.State, error) {
+
+	state, ok := h.executions.Get(requestID)
+	if ok {
+		return state, nil
+	}
+
+	record, err := h.executionResult(ctx, requestID)
+	if err != nil {
+		return "", fmt.Errorf("could not retrieve execution result: %w", err)
+	}
+
+	return finalExecutionState(record.Code), nil
+}
+
+// finalExecutionState returns the state of a completed execution, based on its outcome.
+func finalExecutionState(code codes.Code) execute.State {
+
+	switch code {
+	case codes.OK:
+		return execute.StateDone
+	case codes.Canceled:
+		return execute.StateCanceled
+	default:
+		return execute.StateFailed
+	}
+}
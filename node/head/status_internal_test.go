@@ -0,0 +1,77 @@
+package head
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/store"
+	"github.com/blocklessnetwork/b7s/store/codec"
+	"github.com/blocklessnetwork/b7s/testing/helpers"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ExecutionStatus(t *testing.T) {
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	t.Run("execution in progress", func(t *testing.T) {
+		const requestID = "in-progress"
+
+		head.setExecutionState(requestID, execute.StateClusterForming)
+
+		state, err := head.ExecutionStatus(ctx, requestID)
+		require.NoError(t, err)
+		require.Equal(t, execute.StateClusterForming, state)
+	})
+	t.Run("execution done", func(t *testing.T) {
+
+		record := mocks.GenericExecutionRecord
+		record.RequestID = "done"
+		record.CompletedAt = time.Now()
+		head.saveExecutionResult(ctx, record)
+
+		state, err := head.ExecutionStatus(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, execute.StateDone, state)
+	})
+	t.Run("execution failed", func(t *testing.T) {
+
+		record := mocks.GenericExecutionRecord
+		record.RequestID = "failed"
+		record.Code = codes.Timeout
+		record.CompletedAt = time.Now()
+		head.saveExecutionResult(ctx, record)
+
+		state, err := head.ExecutionStatus(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, execute.StateFailed, state)
+	})
+	t.Run("execution canceled", func(t *testing.T) {
+
+		record := mocks.GenericExecutionRecord
+		record.RequestID = "canceled"
+		record.Code = codes.Canceled
+		record.CompletedAt = time.Now()
+		head.saveExecutionResult(ctx, record)
+
+		state, err := head.ExecutionStatus(ctx, record.RequestID)
+		require.NoError(t, err)
+		require.Equal(t, execute.StateCanceled, state)
+	})
+	t.Run("unknown request", func(t *testing.T) {
+		_, err := head.ExecutionStatus(ctx, "unknown")
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+}
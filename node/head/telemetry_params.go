@@ -10,8 +10,15 @@ const (
 )
 
 var (
-	rollCallsPublishedMetric = []string{"node", "rollcalls", "published"}
-	executionsMetric         = []string{"node", "function", "executions"}
+	rollCallsPublishedMetric  = []string{"node", "rollcalls", "published"}
+	executionsMetric          = []string{"node", "function", "executions"}
+	executionsRejectedMetric  = []string{"node", "function", "executions", "rejected"}
+	batchExecutionsMetric     = []string{"node", "function", "batch", "executions"}
+	mapReduceExecutionsMetric = []string{"node", "function", "mapreduce", "executions"}
+	unverifiedResultsMetric   = []string{"node", "function", "results", "unverified"}
+
+	executionsActiveMetric    = []string{"node", "function", "executions", "active"}
+	executionQueueDepthMetric = []string{"node", "function", "executions", "queued"}
 )
 
 var Counters = []prometheus.CounterDefinition{
@@ -23,4 +30,31 @@ var Counters = []prometheus.CounterDefinition{
 		Name: executionsMetric,
 		Help: "Number of function executions.",
 	},
+	{
+		Name: executionsRejectedMetric,
+		Help: "Number of function executions rejected because the execution queue was full.",
+	},
+	{
+		Name: batchExecutionsMetric,
+		Help: "Number of batch function executions.",
+	},
+	{
+		Name: mapReduceExecutionsMetric,
+		Help: "Number of map-reduce function executions.",
+	},
+	{
+		Name: unverifiedResultsMetric,
+		Help: "Number of execution results dropped because their signature could not be verified.",
+	},
+}
+
+var Gauges = []prometheus.GaugeDefinition{
+	{
+		Name: executionsActiveMetric,
+		Help: "Number of function executions currently orchestrated by the node.",
+	},
+	{
+		Name: executionQueueDepthMetric,
+		Help: "Number of function executions waiting in the execution queue.",
+	},
 }
@@ -0,0 +1,70 @@
+package head
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// verifyResult checks that the execution result was signed by the peer that sent it.
+func verifyResult(from peer.ID, res execute.NodeResult) error {
+
+	if res.Signature == "" {
+		return errors.New("result is not signed")
+	}
+
+	pub, err := from.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("could not derive public key from peer ID: %w", err)
+	}
+
+	err = res.VerifySignature(pub)
+	if err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+
+	return nil
+}
+
+// unverifiedResult is recorded in place of results that failed verification, so nobody waits for them in vain.
+func unverifiedResult() execute.NodeResult {
+	return execute.NodeResult{
+		Result: execute.Result{
+			Code: codes.NotAuthorized,
+		},
+	}
+}
+
+// resultUnverified returns true if the result the peer sent for the request failed verification.
+// The record of failed verification is cleared, so this should be called once per result.
+func (h *HeadNode) resultUnverified(requestID string, id peer.ID) bool {
+
+	key := peerRequestKey(requestID, id)
+
+	_, ok := h.unverifiedResults.Get(key)
+	if ok {
+		h.unverifiedResults.Delete(key)
+	}
+
+	return ok
+}
+
+// dropUnverifiedResults removes the results that failed verification. Returned are the peers whose results were dropped.
+func (h *HeadNode) dropUnverifiedResults(requestID string, peers []peer.ID, results execute.ResultMap) []peer.ID {
+
+	var dropped []peer.ID
+	for _, id := range peers {
+		if !h.resultUnverified(requestID, id) {
+			continue
+		}
+
+		delete(results, id)
+		dropped = append(dropped, id)
+	}
+
+	return dropped
+}
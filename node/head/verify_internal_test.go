@@ -0,0 +1,112 @@
+package head
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_ProcessWorkOrderResponse_Verification(t *testing.T) {
+
+	const requestID = "dummy-request-id"
+
+	newPeer := func(t *testing.T) (peer.ID, crypto.PrivKey) {
+		t.Helper()
+
+		key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		require.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+
+		return id, key
+	}
+
+	signedResult := func(t *testing.T, key crypto.PrivKey) execute.NodeResult {
+		t.Helper()
+
+		res := execute.NodeResult{
+			Result: execute.Result{
+				Code:   codes.OK,
+				Result: execute.RuntimeOutput{Stdout: "dummy-stdout"},
+			},
+		}
+		require.NoError(t, res.Sign(key))
+
+		return res
+	}
+
+	var (
+		honest, honestKey = newPeer(t)
+		forger, _         = newPeer(t)
+		lazy, _           = newPeer(t)
+		_, otherKey       = newPeer(t)
+	)
+
+	head := createHeadNode(t)
+
+	responses := map[peer.ID]execute.NodeResult{
+		honest: signedResult(t, honestKey),
+		forger: signedResult(t, otherKey), // Signed by someone else.
+		lazy:   {Result: execute.Result{Code: codes.OK}},
+	}
+
+	for from, res := range responses {
+		err := head.processWorkOrderResponse(context.Background(), from, response.WorkOrder{
+			RequestID: requestID,
+			Code:      res.Code,
+			Result:    res,
+		})
+		require.NoError(t, err)
+	}
+
+	// Results are recorded for everyone, so nobody waits in vain.
+	results := make(execute.ResultMap)
+	for from := range responses {
+		res, ok := head.workOrderResponses.Get(peerRequestKey(requestID, from))
+		require.True(t, ok)
+		results[from] = res
+	}
+
+	require.Equal(t, responses[honest], results[honest])
+	require.Equal(t, codes.NotAuthorized, results[forger].Code)
+	require.Equal(t, codes.NotAuthorized, results[lazy].Code)
+
+	dropped := head.dropUnverifiedResults(requestID, []peer.ID{honest, forger, lazy}, results)
+	require.ElementsMatch(t, []peer.ID{forger, lazy}, dropped)
+	require.Len(t, results, 1)
+	require.Contains(t, results, honest)
+
+	// Record of failed verification is cleared once the results are dropped.
+	require.Empty(t, head.unverifiedResults.Keys())
+}
+
+func TestVerifyResult(t *testing.T) {
+
+	res := execute.NodeResult{
+		Result: mocks.GenericExecutionResult,
+	}
+
+	key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	require.Error(t, verifyResult(id, res))
+
+	require.NoError(t, res.Sign(key))
+	require.NoError(t, verifyResult(id, res))
+
+	// Result modified after signing.
+	res.Result.Result.Stdout = "tampered-stdout"
+	require.Error(t, verifyResult(id, res))
+}
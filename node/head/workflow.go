@@ -0,0 +1,366 @@
+package head
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/node/aggregate"
+)
+
+// ExecuteWorkflow starts the workflow in the background and returns its ID. Progress can be tracked using `Workflow`.
+func (h *HeadNode) ExecuteWorkflow(ctx context.Context, workflow execute.Workflow, subgroup string) (string, error) {
+
+	err := workflow.Valid()
+	if err != nil {
+		return "", fmt.Errorf("invalid workflow: %w", err)
+	}
+
+	record := blockless.WorkflowRecord{
+		ID:        newRequestID(),
+		Workflow:  workflow,
+		Topic:     subgroup,
+		State:     execute.StateExecuting,
+		Steps:     make([]blockless.WorkflowStepRecord, 0, len(workflow.Steps)),
+		StartedAt: time.Now().UTC(),
+	}
+
+	for _, step := range workflow.Steps {
+		record.Steps = append(record.Steps, blockless.WorkflowStepRecord{
+			ID:    step.ID,
+			State: execute.StepPending,
+		})
+	}
+
+	// Track the workflow before it is saved, so it's not mistaken for one interrupted by a restart.
+	h.workflows.Set(record.ID, struct{}{})
+
+	err = h.store.SaveWorkflow(ctx, record)
+	if err != nil {
+		h.workflows.Delete(record.ID)
+		return "", fmt.Errorf("could not save workflow: %w", err)
+	}
+
+	h.Log().Info().Str("workflow", record.ID).Int("steps", len(workflow.Steps)).Msg("workflow started")
+
+	// Workflow should outlive the request that started it.
+	go h.runWorkflow(context.WithoutCancel(ctx), newWorkflowRun(record))
+
+	return record.ID, nil
+}
+
+// Workflow returns the workflow with the given ID, along with the progress of its steps.
+func (h *HeadNode) Workflow(ctx context.Context, id string) (blockless.WorkflowRecord, error) {
+
+	record, err := h.store.RetrieveWorkflow(ctx, id)
+	if err != nil {
+		return blockless.WorkflowRecord{}, fmt.Errorf("could not retrieve workflow: %w", err)
+	}
+
+	return record, nil
+}
+
+// failInterruptedWorkflows marks workflows left executing by a previous run of the node as failed.
+// Their executions were lost with the node, so they would otherwise remain executing forever.
+func (h *HeadNode) failInterruptedWorkflows(ctx context.Context) error {
+
+	records, err := h.store.RetrieveWorkflows(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve workflows: %w", err)
+	}
+
+	const message = "workflow was interrupted by a node restart"
+
+	now := time.Now().UTC()
+	for _, record := range records {
+
+		if record.State != execute.StateExecuting {
+			continue
+		}
+
+		_, running := h.workflows.Get(record.ID)
+		if running {
+			continue
+		}
+
+		for i := range record.Steps {
+			step := &record.Steps[i]
+
+			switch step.State {
+			case execute.StepRunning:
+				step.State = execute.StepFailed
+				step.Message = message
+				step.CompletedAt = now
+
+			case execute.StepPending:
+				step.State = execute.StepSkipped
+				step.Message = message
+			}
+		}
+
+		record.State = execute.StateFailed
+		record.CompletedAt = now
+
+		err = h.store.SaveWorkflow(ctx, record)
+		if err != nil {
+			return fmt.Errorf("could not save workflow (id: %s): %w", record.ID, err)
+		}
+
+		h.Log().Info().Str("workflow", record.ID).Msg("workflow interrupted by restart marked as failed")
+	}
+
+	return nil
+}
+
+// workflowRun tracks the progress of a single workflow.
+type workflowRun struct {
+	sync.Mutex
+
+	record blockless.WorkflowRecord
+	index  map[string]int // Maps step ID to the index of the step in the workflow.
+}
+
+func newWorkflowRun(record blockless.WorkflowRecord) *workflowRun {
+
+	run := workflowRun{
+		record: record,
+		index:  make(map[string]int, len(record.Steps)),
+	}
+
+	for i, step := range record.Workflow.Steps {
+		run.index[step.ID] = i
+	}
+
+	return &run
+}
+
+// runWorkflow runs the workflow steps, each one as soon as all of its dependencies are finished.
+func (h *HeadNode) runWorkflow(ctx context.Context, run *workflowRun) {
+
+	log := h.Log().With().Str("workflow", run.record.ID).Logger()
+
+	var (
+		finished = make(chan struct{}, len(run.record.Workflow.Steps))
+		running  int
+	)
+
+	for {
+		// Start the steps that are ready. Skipping a step can make others ready, so repeat until there's nothing left to do.
+		for {
+			ready := run.ready()
+			if len(ready) == 0 {
+				break
+			}
+
+			for _, step := range ready {
+
+				reason, ok := run.runnable(step)
+				if !ok {
+					log.Info().Str("step", step.ID).Str("reason", reason).Msg("skipping workflow step")
+
+					run.update(step.ID, func(rec *blockless.WorkflowStepRecord) {
+						rec.State = execute.StepSkipped
+						rec.Message = reason
+					})
+					h.saveWorkflow(ctx, run)
+					continue
+				}
+
+				run.update(step.ID, func(rec *blockless.WorkflowStepRecord) {
+					rec.State = execute.StepRunning
+					rec.RequestID = newRequestID()
+					rec.StartedAt = time.Now().UTC()
+				})
+				h.saveWorkflow(ctx, run)
+
+				running++
+				go func(step execute.WorkflowStep) {
+					h.runWorkflowStep(ctx, run, step)
+					finished <- struct{}{}
+				}(step)
+			}
+		}
+
+		if running == 0 {
+			break
+		}
+
+		<-finished
+		running--
+	}
+
+	state := run.finish()
+	h.saveWorkflow(ctx, run)
+
+	h.workflows.Delete(run.record.ID)
+
+	log.Info().Stringer("state", state).Msg("workflow complete")
+}
+
+// runWorkflowStep executes the workflow step and records the outcome.
+func (h *HeadNode) runWorkflowStep(ctx context.Context, run *workflowRun, step execute.WorkflowStep) {
+
+	requestID, req := run.request(step)
+
+	log := h.Log().With().Str("workflow", run.record.ID).Str("step", step.ID).Str("request", requestID).Logger()
+
+	var (
+		code    codes.Code
+		results execute.ResultMap
+		message string
+	)
+
+	ticket, err := h.admitExecution(requestID, req.Request)
+	if err != nil {
+		code = codes.TooManyRequests
+		message = err.Error()
+	} else {
+		code, results, _, err = h.runExecution(ctx, requestID, req, ticket)
+		message = executionFailureMessage(err)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msg("workflow step execution failed")
+	}
+
+	// Only successful executions can provide the step output.
+	valid := make(execute.ResultMap, len(results))
+	for id, res := range results {
+		if res.Code == codes.OK {
+			valid[id] = res
+		}
+	}
+
+	aggregated, aerr := aggregate.AggregateWith(req.Config.ResultAggregation, valid, aggregate.WithWeights(h.reputation.score))
+	if aerr != nil {
+		log.Warn().Err(aerr).Msg("could not aggregate workflow step results")
+		message = cmp.Or(message, fmt.Sprintf("could not aggregate results: %s", aerr))
+	}
+
+	run.update(step.ID, func(rec *blockless.WorkflowStepRecord) {
+		rec.Code = code
+		rec.CompletedAt = time.Now().UTC()
+
+		// Without a valid result there is nothing to pass on to later steps.
+		if code != codes.OK || len(aggregated) == 0 {
+			rec.State = execute.StepFailed
+			rec.Message = cmp.Or(message, fmt.Sprintf("execution did not produce a valid result (code: %s)", code))
+			return
+		}
+
+		// Top result is the step output.
+		rec.State = execute.StepSucceeded
+		rec.Stdout = aggregated[0].Result.Stdout
+		rec.ExitCode = aggregated[0].Result.ExitCode
+	})
+	h.saveWorkflow(ctx, run)
+
+	log.Info().Stringer("code", code).Msg("workflow step complete")
+}
+
+func (h *HeadNode) saveWorkflow(ctx context.Context, run *workflowRun) {
+	run.Lock()
+	defer run.Unlock()
+
+	err := h.store.SaveWorkflow(ctx, run.record)
+	if err != nil {
+		h.Log().Error().Err(err).Str("workflow", run.record.ID).Msg("could not save workflow")
+	}
+}
+
+// ready returns pending steps whose dependencies are all finished.
+func (r *workflowRun) ready() []execute.WorkflowStep {
+	r.Lock()
+	defer r.Unlock()
+
+	var ready []execute.WorkflowStep
+	for i, step := range r.record.Workflow.Steps {
+
+		if r.record.Steps[i].State != execute.StepPending {
+			continue
+		}
+
+		finished := true
+		for _, dep := range step.Dependencies() {
+			if !r.record.Steps[r.index[dep]].State.Finished() {
+				finished = false
+				break
+			}
+		}
+
+		if finished {
+			ready = append(ready, step)
+		}
+	}
+
+	return ready
+}
+
+// runnable checks if the step should run. If not, the reason is returned.
+func (r *workflowRun) runnable(step execute.WorkflowStep) (string, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, dep := range step.Dependencies() {
+		if r.record.Steps[r.index[dep]].State != execute.StepSucceeded {
+			return fmt.Sprintf("dependency did not succeed (step: %s)", dep), false
+		}
+	}
+
+	if step.When != nil {
+		exitCode := r.record.Steps[r.index[step.When.Step]].ExitCode
+		if !step.When.Met(exitCode) {
+			return fmt.Sprintf("condition not met (step: %s, exit code: %d)", step.When.Step, exitCode), false
+		}
+	}
+
+	return "", true
+}
+
+// request returns the execution request for the step, using the outputs of earlier steps.
+func (r *workflowRun) request(step execute.WorkflowStep) (string, request.Execute) {
+	r.Lock()
+	defer r.Unlock()
+
+	outputs := make(map[string]string)
+	for _, input := range step.Inputs {
+		outputs[input.Step] = r.record.Steps[r.index[input.Step]].Stdout
+	}
+
+	req := request.Execute{
+		Request: step.Request(outputs),
+		Topic:   r.record.Topic,
+	}
+
+	return r.record.Steps[r.index[step.ID]].RequestID, req
+}
+
+func (r *workflowRun) update(id string, fn func(*blockless.WorkflowStepRecord)) {
+	r.Lock()
+	defer r.Unlock()
+
+	fn(&r.record.Steps[r.index[id]])
+}
+
+// finish sets the final state of the workflow - it failed if any of the steps failed.
+func (r *workflowRun) finish() execute.State {
+	r.Lock()
+	defer r.Unlock()
+
+	r.record.State = execute.StateDone
+	for _, step := range r.record.Steps {
+		if step.State == execute.StepFailed {
+			r.record.State = execute.StateFailed
+			break
+		}
+	}
+
+	r.record.CompletedAt = time.Now().UTC()
+
+	return r.record.State
+}
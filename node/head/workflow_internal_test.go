@@ -0,0 +1,263 @@
+package head
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/store"
+	"github.com/blocklessnetwork/b7s/store/codec"
+	"github.com/blocklessnetwork/b7s/testing/helpers"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestHead_Workflow(t *testing.T) {
+
+	const (
+		echoFunction  = "echo"
+		upperFunction = "upper"
+		failFunction  = "fail"
+	)
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	var (
+		ctx      = context.Background()
+		workerID = mocks.GenericPeerID
+	)
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	// Worker outputs its standard input followed by the parameters, upper-cased if requested.
+	core := mocks.BaselineNodeCore(t)
+	core.ConnectedFunc = func(peer.ID) bool {
+		return true
+	}
+	core.PublishToTopicFunc = func(_ context.Context, _ string, msg blockless.Message) error {
+
+		rc, ok := any(msg).(*request.RollCall)
+		require.True(t, ok)
+
+		head.rollCall.add(rc.RequestID, rollCallResponse{
+			From: workerID,
+			RollCall: response.RollCall{
+				Code:       codes.Accepted,
+				FunctionID: rc.FunctionID,
+				RequestID:  rc.RequestID,
+			},
+		})
+
+		return nil
+	}
+	core.SendToManyFunc = func(_ context.Context, _ []peer.ID, msg blockless.Message, _ bool) error {
+
+		wo, ok := any(msg).(*request.WorkOrder)
+		require.True(t, ok)
+
+		var parts []string
+		if wo.Config.Stdin != nil {
+			parts = append(parts, *wo.Config.Stdin)
+		}
+		for _, param := range wo.Parameters {
+			parts = append(parts, param.Value)
+		}
+
+		stdout := strings.Join(parts, " ")
+		if wo.FunctionID == upperFunction {
+			stdout = strings.ToUpper(stdout)
+		}
+
+		code := codes.OK
+		if wo.FunctionID == failFunction {
+			code = codes.Error
+		}
+
+		head.workOrderResponses.Set(peerRequestKey(wo.RequestID, workerID), execute.NodeResult{
+			Result: execute.Result{
+				Code:   code,
+				Result: execute.RuntimeOutput{Stdout: stdout},
+			},
+		})
+
+		return nil
+	}
+	head.Core = core
+
+	workflow := execute.Workflow{
+		Steps: []execute.WorkflowStep{
+			{
+				ID:         "fetch",
+				FunctionID: echoFunction,
+				Method:     "echo.wasm",
+				Parameters: []execute.Parameter{{Value: "hello"}},
+			},
+			{
+				ID:         "shout",
+				FunctionID: upperFunction,
+				Method:     "upper.wasm",
+				Inputs:     []execute.StepInput{{Step: "fetch", As: execute.InputStdin}},
+			},
+			{
+				ID:         "on-success",
+				FunctionID: echoFunction,
+				Method:     "echo.wasm",
+				Parameters: []execute.Parameter{{Value: "got"}},
+				Inputs:     []execute.StepInput{{Step: "shout", As: execute.InputParameter}},
+				When:       &execute.StepCondition{Step: "fetch", ExitCode: 0},
+			},
+			{
+				ID:         "on-failure",
+				FunctionID: echoFunction,
+				Method:     "echo.wasm",
+				When:       &execute.StepCondition{Step: "fetch", Operator: execute.ConditionNotEqual, ExitCode: 0},
+			},
+			{
+				ID:         "after-failure",
+				FunctionID: echoFunction,
+				Method:     "echo.wasm",
+				DependsOn:  []string{"on-failure"},
+			},
+			{
+				ID:         "broken",
+				FunctionID: failFunction,
+				Method:     "fail.wasm",
+				Parameters: []execute.Parameter{{Value: "partial output"}},
+			},
+		},
+	}
+
+	id, err := head.ExecuteWorkflow(ctx, workflow, "")
+	require.NoError(t, err)
+
+	var record blockless.WorkflowRecord
+	require.Eventually(t, func() bool {
+		record, err = head.Workflow(ctx, id)
+		require.NoError(t, err)
+		return record.State != execute.StateExecuting
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Workflow failed because of the broken step.
+	require.Equal(t, execute.StateFailed, record.State)
+
+	steps := make(map[string]blockless.WorkflowStepRecord)
+	for _, step := range record.Steps {
+		steps[step.ID] = step
+	}
+
+	require.Equal(t, execute.StepSucceeded, steps["fetch"].State)
+	require.Equal(t, "hello", steps["fetch"].Stdout)
+
+	require.Equal(t, execute.StepSucceeded, steps["shout"].State)
+	require.Equal(t, "HELLO", steps["shout"].Stdout)
+
+	require.Equal(t, execute.StepSucceeded, steps["on-success"].State)
+	require.Equal(t, "got HELLO", steps["on-success"].Stdout)
+
+	require.Equal(t, execute.StepSkipped, steps["on-failure"].State)
+	require.Equal(t, execute.StepSkipped, steps["after-failure"].State)
+
+	// Failed execution is not a step result, even if it produced output.
+	require.Equal(t, execute.StepFailed, steps["broken"].State)
+	require.Empty(t, steps["broken"].Stdout)
+	require.NotEmpty(t, steps["broken"].Message)
+
+	// Step executions are persisted like any other execution.
+	result, err := head.ExecutionResult(ctx, steps["shout"].RequestID)
+	require.NoError(t, err)
+	require.Equal(t, codes.OK, result.Code)
+}
+
+func TestHead_FailInterruptedWorkflows(t *testing.T) {
+
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	head, err := New(mocks.BaselineNodeCore(t), store.New(db, codec.NewJSONCodec()))
+	require.NoError(t, err)
+
+	workflow := execute.Workflow{
+		Steps: []execute.WorkflowStep{
+			{ID: "first", FunctionID: mocks.GenericExecutionRequest.FunctionID, Method: mocks.GenericExecutionRequest.Method},
+			{ID: "second", FunctionID: mocks.GenericExecutionRequest.FunctionID, Method: mocks.GenericExecutionRequest.Method, DependsOn: []string{"first"}},
+			{ID: "third", FunctionID: mocks.GenericExecutionRequest.FunctionID, Method: mocks.GenericExecutionRequest.Method, DependsOn: []string{"second"}},
+		},
+	}
+
+	started := time.Now().Add(-time.Hour).UTC()
+
+	// Workflow left executing by a previous run of the node.
+	interrupted := blockless.WorkflowRecord{
+		ID:       "interrupted",
+		Workflow: workflow,
+		State:    execute.StateExecuting,
+		Steps: []blockless.WorkflowStepRecord{
+			{ID: "first", State: execute.StepSucceeded, Code: codes.OK, Stdout: "done", StartedAt: started, CompletedAt: started},
+			{ID: "second", State: execute.StepRunning, RequestID: newRequestID(), StartedAt: started},
+			{ID: "third", State: execute.StepPending},
+		},
+		StartedAt: started,
+	}
+
+	// Workflow running on this node.
+	running := interrupted
+	running.ID = "running"
+	running.Steps = []blockless.WorkflowStepRecord{
+		{ID: "first", State: execute.StepRunning, RequestID: newRequestID(), StartedAt: started},
+		{ID: "second", State: execute.StepPending},
+		{ID: "third", State: execute.StepPending},
+	}
+	head.workflows.Set(running.ID, struct{}{})
+
+	finished := mocks.GenericWorkflowRecord
+
+	for _, record := range []blockless.WorkflowRecord{interrupted, running, finished} {
+		require.NoError(t, head.store.SaveWorkflow(ctx, record))
+	}
+
+	err = head.failInterruptedWorkflows(ctx)
+	require.NoError(t, err)
+
+	t.Run("interrupted workflow is failed", func(t *testing.T) {
+
+		record, err := head.Workflow(ctx, interrupted.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, execute.StateFailed, record.State)
+		require.False(t, record.CompletedAt.IsZero())
+
+		// Finished steps are kept as they were.
+		require.Equal(t, interrupted.Steps[0], record.Steps[0])
+
+		require.Equal(t, execute.StepFailed, record.Steps[1].State)
+		require.NotEmpty(t, record.Steps[1].Message)
+		require.False(t, record.Steps[1].CompletedAt.IsZero())
+
+		require.Equal(t, execute.StepSkipped, record.Steps[2].State)
+		require.NotEmpty(t, record.Steps[2].Message)
+	})
+	t.Run("running workflow is not affected", func(t *testing.T) {
+
+		record, err := head.Workflow(ctx, running.ID)
+		require.NoError(t, err)
+		require.Equal(t, running, record)
+	})
+	t.Run("finished workflow is not affected", func(t *testing.T) {
+
+		record, err := head.Workflow(ctx, finished.ID)
+		require.NoError(t, err)
+		require.Equal(t, finished, record)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-multierror"
@@ -178,6 +179,22 @@ func (c *core) JoinTopic(topic string) error {
 	return nil
 }
 
+// Topics returns the names of the topics the node is subscribed to, in alphabetical order.
+func (c *core) Topics() []string {
+
+	var topics []string
+	for _, name := range c.topics.Keys() {
+		ti, ok := c.topics.Get(name)
+		if ok && ti.subscription != nil {
+			topics = append(topics, name)
+		}
+	}
+
+	slices.Sort(topics)
+
+	return topics
+}
+
 func (c *core) Connected(peer peer.ID) bool {
 	connections := c.host.Network().ConnsToPeer(peer)
 	return len(connections) > 0
@@ -70,11 +70,13 @@ func correctPipeline(msg string, pipeline Pipeline) bool {
 	// Messages we don't allow to be published.
 	case
 		blockless.MessageInstallFunctionResponse,
+		blockless.MessageUninstallFunctionResponse,
 		blockless.MessageExecute,
 		blockless.MessageExecuteResponse,
 		blockless.MessageFormCluster,
 		blockless.MessageFormClusterResponse,
 		blockless.MessageDisbandCluster,
+		blockless.MessageCancelWorkOrder,
 		blockless.MessageRollCallResponse:
 
 		return false
@@ -21,11 +21,13 @@ func TestNode_DisallowedMessages(t *testing.T) {
 	}{
 		// Messages disallowed for publishing.
 		{pubsub, blockless.MessageInstallFunctionResponse},
+		{pubsub, blockless.MessageUninstallFunctionResponse},
 		{pubsub, blockless.MessageExecute},
 		{pubsub, blockless.MessageExecuteResponse},
 		{pubsub, blockless.MessageFormCluster},
 		{pubsub, blockless.MessageFormClusterResponse},
 		{pubsub, blockless.MessageDisbandCluster},
+		{pubsub, blockless.MessageCancelWorkOrder},
 		// Messages disallowed for direct sending.
 		{direct, blockless.MessageHealthCheck},
 		{direct, blockless.MessageRollCall},
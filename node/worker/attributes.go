@@ -4,11 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/ipfs/boxo/ipns"
-
-	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
 
 	"github.com/blocklessnetwork/b7s-attributes/attributes"
 	"github.com/blocklessnetwork/b7s/models/blockless"
@@ -17,23 +21,108 @@ import (
 
 const (
 	defaultAttributesFilename = "attributes.bin"
+
+	// Placeholder for the IPNS name in gateway URLs using subdomain resolution.
+	ipnsNamePlaceholder = "{name}"
 )
 
-func loadAttributes(key crypto.PubKey) (attributes.Attestation, error) {
+// loadAttributes loads the node attributes from the configured source. Sources are, in order of preference:
+// the local attestation file, the self-declared attributes and the attestation published on IPFS.
+// Attestation signatures are verified before the attributes are used. Returned is nil if no attribute source is configured.
+func loadAttributes(cfg Config, id peer.ID, log zerolog.Logger) (*attributes.Attestation, error) {
+
+	var (
+		att attributes.Attestation
+		err error
+	)
+
+	switch {
+	case cfg.AttributeFile != "":
+		att, err = loadAttributesFromFile(cfg.AttributeFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load attributes from file: %w", err)
+		}
+
+	case len(cfg.DeclaredAttributes) > 0:
+		// Self-declared attributes are not signed nor attested, so there's nothing to verify.
+		att = declaredAttributes(cfg.DeclaredAttributes)
+		return &att, nil
+
+	case cfg.LoadAttributes:
+		att, err = loadAttributesFromGateways(cfg.AttributeGateways, cfg.AttributeTimeout, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not load attributes from IPFS: %w", err)
+		}
+
+	default:
+		return nil, nil
+	}
+
+	att, err = verifyAttestation(att, id, log)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify attestation: %w", err)
+	}
+
+	return &att, nil
+}
+
+func loadAttributesFromFile(path string) (attributes.Attestation, error) {
+
+	f, err := os.Open(path)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("could not open attribute file: %w", err)
+	}
+	defer f.Close()
 
-	name, err := getAttributesIPNSName(key)
+	att, err := attributes.ImportAttestation(f)
 	if err != nil {
-		return attributes.Attestation{}, fmt.Errorf("could not get name from key: %w", err)
+		return attributes.Attestation{}, fmt.Errorf("could not load attestation from file: %w", err)
+	}
+
+	return att, nil
+}
+
+// loadAttributesFromGateways tries the gateways in order, until the attestation is successfully loaded from one of them.
+func loadAttributesFromGateways(gateways []string, timeout time.Duration, id peer.ID) (attributes.Attestation, error) {
+
+	name := ipns.NameFromPeer(id).String()
+	client := http.Client{
+		Timeout: timeout,
+	}
+
+	var errs *multierror.Error
+	for _, gateway := range gateways {
+
+		attributeURL := ipnsGatewayURL(gateway, name)
+
+		att, err := loadAttributesFromURL(&client, attributeURL)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("could not load attributes from gateway (url: %s): %w", attributeURL, err))
+			continue
+		}
+
+		return att, nil
+	}
+
+	if errs == nil {
+		return attributes.Attestation{}, errors.New("no gateways specified")
 	}
 
-	attributeURL := ipnsGatewayURL(name)
+	return attributes.Attestation{}, errs
+}
+
+func loadAttributesFromURL(client *http.Client, url string) (attributes.Attestation, error) {
 
-	res, err := http.Get(attributeURL)
+	res, err := client.Get(url)
 	if err != nil {
 		return attributes.Attestation{}, fmt.Errorf("could not get attribute file from URL: %w", err)
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode != http.StatusOK {
+		return attributes.Attestation{}, fmt.Errorf("unexpected response status: %s", res.Status)
+	}
+
 	att, err := attributes.ImportAttestation(res.Body)
 	if err != nil {
 		return attributes.Attestation{}, fmt.Errorf("could not load attestation from file: %w", err)
@@ -42,19 +131,80 @@ func loadAttributes(key crypto.PubKey) (attributes.Attestation, error) {
 	return att, nil
 }
 
-func getAttributesIPNSName(key crypto.PubKey) (string, error) {
+// ipnsGatewayURL returns the URL of the attribute file for the given gateway and IPNS name. Gateway can either use
+// the `{name}` placeholder (subdomain resolution), or the name will be added to the path.
+func ipnsGatewayURL(gateway string, name string) string {
 
-	id, err := peer.IDFromPublicKey(key)
-	if err != nil {
-		return "", fmt.Errorf("could not get peer ID for key: %w", err)
+	gateway = strings.TrimSuffix(gateway, "/")
+
+	if strings.Contains(gateway, ipnsNamePlaceholder) {
+		return fmt.Sprintf("%s/%s", strings.ReplaceAll(gateway, ipnsNamePlaceholder, name), defaultAttributesFilename)
 	}
 
-	name := ipns.NameFromPeer(id)
-	return name.String(), nil
+	return fmt.Sprintf("%s/ipns/%s/%s", gateway, name, defaultAttributesFilename)
 }
 
-func ipnsGatewayURL(name string) string {
-	return fmt.Sprintf("https://%s.ipns.cf-ipfs.com/%s", name, defaultAttributesFilename)
+func declaredAttributes(values map[string]string) attributes.Attestation {
+
+	var att attributes.Attestation
+	for name, value := range values {
+		att.Attributes = append(att.Attributes, attributes.Attribute{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	// Keep the order stable.
+	slices.SortFunc(att.Attributes, func(a, b attributes.Attribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return att
+}
+
+// verifyAttestation verifies that the attributes were signed by this node and checks attestor signatures.
+// Attestors whose signatures cannot be verified are dropped, so they cannot be used to satisfy attestation requirements.
+func verifyAttestation(att attributes.Attestation, id peer.ID, log zerolog.Logger) (attributes.Attestation, error) {
+
+	// Attestors vouch for the attributes signed by the node. If there's no signature, there's nothing to vouch for.
+	if att.Signature == nil {
+		if len(att.Attestors) > 0 {
+			log.Warn().Int("attestors", len(att.Attestors)).Msg("dropping attestors of unsigned attributes")
+		}
+
+		att.Attestors = nil
+		return att, nil
+	}
+
+	if att.Signature.Signer != id {
+		return attributes.Attestation{}, fmt.Errorf("attributes signed by a different node (signer: %s)", att.Signature.Signer.String())
+	}
+
+	signed := attributes.Attestation{
+		Attributes: att.Attributes,
+		Signature:  att.Signature,
+	}
+
+	err := attributes.Validate(signed)
+	if err != nil {
+		return attributes.Attestation{}, fmt.Errorf("invalid node signature: %w", err)
+	}
+
+	for _, attestor := range att.Attestors {
+
+		attested := signed
+		attested.Attestors = []attributes.Signature{attestor}
+
+		err := attributes.Validate(attested)
+		if err != nil {
+			log.Warn().Err(err).Stringer("attestor", attestor.Signer).Msg("dropping attestor with invalid signature")
+			continue
+		}
+
+		signed.Attestors = append(signed.Attestors, attestor)
+	}
+
+	return signed, nil
 }
 
 func haveAttributes(have attributes.Attestation, want execute.Attributes) error {
@@ -99,7 +249,7 @@ func haveAttributes(have attributes.Attestation, want execute.Attributes) error
 
 	// It doesn't make a lot of sense to require attestors without wanting specific attributes,
 	// but if that's the case, and there's no attributes wanted, we're done now.
-	if len(want.Values) == 0 {
+	if len(want.Values) == 0 && len(want.Conditions) == 0 {
 		return nil
 	}
 
@@ -120,5 +270,14 @@ func haveAttributes(have attributes.Attestation, want execute.Attributes) error
 		}
 	}
 
+	for _, cond := range want.Conditions {
+
+		value, ok := attrs[cond.Name]
+		err := cond.Match(value, ok)
+		if err != nil {
+			return fmt.Errorf("attribute condition not satisfied: %w", err)
+		}
+	}
+
 	return nil
 }
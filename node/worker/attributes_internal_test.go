@@ -0,0 +1,292 @@
+package worker
+
+import (
+	"bytes"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ipfs/boxo/ipns"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s-attributes/attributes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+func TestWorker_HaveAttributes(t *testing.T) {
+
+	have := attributes.Attestation{
+		Attributes: []attributes.Attribute{
+			{Name: "region", Value: "eu"},
+			{Name: "ram", Value: "32"},
+			{Name: "runtime_version", Value: "0.3.1"},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		want  execute.Attributes
+		match bool
+	}{
+		{
+			name: "equality",
+			want: execute.Attributes{
+				Values: []execute.Parameter{{Name: "region", Value: "eu"}},
+			},
+			match: true,
+		},
+		{
+			name: "all conditions satisfied",
+			want: execute.Attributes{
+				Values: []execute.Parameter{{Name: "region", Value: "eu"}},
+				Conditions: []execute.AttributeCondition{
+					{Name: "ram", Operator: execute.AttributeGreaterOrEqual, Value: "16"},
+					{Name: "region", Operator: execute.AttributeIn, Values: []string{"eu", "us"}},
+					{Name: "runtime_version", Operator: execute.AttributeSemver, Value: ">=0.3.0 <0.4.0"},
+					{Name: "gpu", Operator: execute.AttributeExists, Negate: true},
+				},
+			},
+			match: true,
+		},
+		{
+			name: "one condition not satisfied",
+			want: execute.Attributes{
+				Conditions: []execute.AttributeCondition{
+					{Name: "ram", Operator: execute.AttributeGreaterOrEqual, Value: "16"},
+					{Name: "ram", Operator: execute.AttributeGreaterThan, Value: "64"},
+				},
+			},
+			match: false,
+		},
+		{
+			name: "missing attribute",
+			want: execute.Attributes{
+				Conditions: []execute.AttributeCondition{
+					{Name: "gpu", Operator: execute.AttributeExists},
+				},
+			},
+			match: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			err := haveAttributes(have, test.want)
+			if test.match {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorker_LoadAttributes(t *testing.T) {
+
+	var (
+		nodeID, nodeKey   = newAttributeSigner(t)
+		_, attestorKey    = newAttributeSigner(t)
+		otherID, otherKey = newAttributeSigner(t)
+
+		attrs = []attributes.Attribute{
+			{Name: "region", Value: "eu"},
+			{Name: "ram", Value: "32"},
+		}
+
+		log = zerolog.Nop()
+	)
+
+	t.Run("no source configured", func(t *testing.T) {
+
+		att, err := loadAttributes(Config{}, nodeID, log)
+		require.NoError(t, err)
+		require.Nil(t, att)
+	})
+	t.Run("declared attributes", func(t *testing.T) {
+
+		cfg := Config{
+			DeclaredAttributes: map[string]string{
+				"region": "eu",
+				"ram":    "32",
+			},
+		}
+
+		att, err := loadAttributes(cfg, nodeID, log)
+		require.NoError(t, err)
+		require.NotNil(t, att)
+		require.ElementsMatch(t, attrs, att.Attributes)
+		require.Nil(t, att.Signature)
+		require.Empty(t, att.Attestors)
+	})
+	t.Run("attested attributes from file", func(t *testing.T) {
+
+		att := attestAttributes(t, attrs, nodeID, nodeKey, attestorKey)
+		cfg := Config{
+			AttributeFile: writeAttestation(t, att),
+		}
+
+		loaded, err := loadAttributes(cfg, nodeID, log)
+		require.NoError(t, err)
+		require.NotNil(t, loaded)
+		require.Equal(t, attrs, loaded.Attributes)
+		require.Len(t, loaded.Attestors, 1)
+	})
+	t.Run("attestors with invalid signatures are dropped", func(t *testing.T) {
+
+		att := attestAttributes(t, attrs, nodeID, nodeKey, attestorKey, otherKey)
+		// First attestor presents the signature of a different attestor.
+		att.Attestors[0].Signature = att.Attestors[1].Signature
+
+		cfg := Config{
+			AttributeFile: writeAttestation(t, att),
+		}
+
+		loaded, err := loadAttributes(cfg, nodeID, log)
+		require.NoError(t, err)
+		require.NotNil(t, loaded)
+		require.Len(t, loaded.Attestors, 1)
+		require.Equal(t, otherID, loaded.Attestors[0].Signer)
+	})
+	t.Run("attributes signed by a different node", func(t *testing.T) {
+
+		att := attestAttributes(t, attrs, otherID, otherKey, attestorKey)
+		cfg := Config{
+			AttributeFile: writeAttestation(t, att),
+		}
+
+		_, err := loadAttributes(cfg, nodeID, log)
+		require.Error(t, err)
+	})
+	t.Run("tampered attributes", func(t *testing.T) {
+
+		att := attestAttributes(t, attrs, nodeID, nodeKey, attestorKey)
+		att.Attributes = []attributes.Attribute{{Name: "ram", Value: "256"}}
+
+		cfg := Config{
+			AttributeFile: writeAttestation(t, att),
+		}
+
+		_, err := loadAttributes(cfg, nodeID, log)
+		require.Error(t, err)
+	})
+	t.Run("missing file", func(t *testing.T) {
+
+		cfg := Config{
+			AttributeFile: filepath.Join(t.TempDir(), "missing.bin"),
+		}
+
+		_, err := loadAttributes(cfg, nodeID, log)
+		require.Error(t, err)
+	})
+	t.Run("gateway fallback", func(t *testing.T) {
+
+		var buf bytes.Buffer
+		require.NoError(t, attributes.ExportAttestation(&buf, attestAttributes(t, attrs, nodeID, nodeKey, attestorKey)))
+
+		name := ipns.NameFromPeer(nodeID).String()
+
+		unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer unavailable.Close()
+
+		gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/ipns/"+name+"/"+defaultAttributesFilename {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(buf.Bytes())
+		}))
+		defer gateway.Close()
+
+		cfg := Config{
+			LoadAttributes:    true,
+			AttributeGateways: []string{unavailable.URL, gateway.URL},
+			AttributeTimeout:  time.Second,
+		}
+
+		loaded, err := loadAttributes(cfg, nodeID, log)
+		require.NoError(t, err)
+		require.NotNil(t, loaded)
+		require.Equal(t, attrs, loaded.Attributes)
+		require.Len(t, loaded.Attestors, 1)
+
+		cfg.AttributeGateways = []string{unavailable.URL}
+		_, err = loadAttributes(cfg, nodeID, log)
+		require.Error(t, err)
+	})
+}
+
+func TestIPNSGatewayURL(t *testing.T) {
+
+	const name = "dummy-name"
+
+	require.Equal(t, "https://dummy-name.ipns.example.com/attributes.bin", ipnsGatewayURL("https://{name}.ipns.example.com", name))
+	require.Equal(t, "https://example.com/ipns/dummy-name/attributes.bin", ipnsGatewayURL("https://example.com/", name))
+}
+
+func newAttributeSigner(t *testing.T) (peer.ID, crypto.PrivKey) {
+	t.Helper()
+
+	key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	return id, key
+}
+
+func attestAttributes(t *testing.T, attrs []attributes.Attribute, signer peer.ID, key crypto.PrivKey, attestors ...crypto.PrivKey) attributes.Attestation {
+	t.Helper()
+
+	sig, err := attributes.SignAttributes(attrs, key)
+	require.NoError(t, err)
+
+	att := attributes.Attestation{
+		Attributes: attrs,
+		Signature: &attributes.Signature{
+			Signer:    signer,
+			Signature: sig,
+		},
+	}
+
+	for _, attestorKey := range attestors {
+
+		id, err := peer.IDFromPrivateKey(attestorKey)
+		require.NoError(t, err)
+
+		sig, err := attributes.Attest(att, attestorKey)
+		require.NoError(t, err)
+
+		att.Attestors = append(att.Attestors, attributes.Signature{
+			Signer:    id,
+			Signature: sig,
+		})
+	}
+
+	return att
+}
+
+func writeAttestation(t *testing.T, att attributes.Attestation) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), strings.ReplaceAll(t.Name(), "/", "-")+".bin")
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.NoError(t, attributes.ExportAttestation(f, att))
+
+	return path
+}
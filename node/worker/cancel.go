@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/node/internal/syncmap"
+)
+
+// processCancelWorkOrder aborts the execution of the work order, if it's running, and tears down the consensus cluster formed for it.
+// Canceled executions are reported back using the usual work order response.
+func (w *Worker) processCancelWorkOrder(ctx context.Context, from peer.ID, req request.CancelWorkOrder) error {
+
+	log := w.Log().With().
+		Stringer("peer", from).
+		Str("request", req.RequestID).
+		Logger()
+
+	log.Info().Msg("received request to cancel work order")
+
+	// Only the node that requested the work may cancel it.
+	requester, ok := w.requesters.Get(req.RequestID)
+	if !ok || requester != from {
+		return fmt.Errorf("work order cancellation not sent by the requesting node (request: %s, peer: %s)", req.RequestID, from)
+	}
+
+	canceled := w.executor.cancel(req.RequestID)
+	log.Info().Bool("execution_canceled", canceled).Msg("processed work order cancellation")
+
+	_, ok = w.clusters.Get(req.RequestID)
+	if !ok {
+		return nil
+	}
+
+	err := w.leaveCluster(req.RequestID, consensusClusterDisbandTimeout)
+	if err != nil {
+		return fmt.Errorf("could not disband cluster (request: %s): %w", req.RequestID, err)
+	}
+
+	log.Info().Msg("left consensus cluster")
+
+	return nil
+}
+
+// cancelableExecutor wraps an executor, keeping track of running executions so they can be canceled.
+type cancelableExecutor struct {
+	blockless.Executor
+
+	running *syncmap.Map[string, context.CancelCauseFunc]
+}
+
+func newCancelableExecutor(executor blockless.Executor) *cancelableExecutor {
+
+	e := cancelableExecutor{
+		Executor: executor,
+		running:  syncmap.New[string, context.CancelCauseFunc](),
+	}
+
+	return &e
+}
+
+func (e *cancelableExecutor) ExecuteFunction(ctx context.Context, requestID string, req execute.Request) (execute.Result, error) {
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	e.running.Set(requestID, cancel)
+	defer e.running.Delete(requestID)
+
+	res, err := e.Executor.ExecuteFunction(ctx, requestID, req)
+	if errors.Is(context.Cause(ctx), blockless.ErrExecutionCanceled) {
+		res.Code = codes.Canceled
+		return res, blockless.ErrExecutionCanceled
+	}
+
+	return res, err
+}
+
+// count returns the number of running executions.
+func (e *cancelableExecutor) count() uint {
+
+	var n uint
+	e.running.WithRLock(func(data map[string]context.CancelCauseFunc) {
+		n = uint(len(data))
+	})
+
+	return n
+}
+
+// cancel aborts the execution for the given request. It returns false if there was no running execution.
+func (e *cancelableExecutor) cancel(requestID string) bool {
+
+	cancel, ok := e.running.Get(requestID)
+	if !ok {
+		return false
+	}
+
+	cancel(blockless.ErrExecutionCanceled)
+	return true
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/execute"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_CancelExecution(t *testing.T) {
+
+	const requestID = "dummy-request-id"
+
+	var (
+		started  = make(chan struct{})
+		executor = mocks.BaselineExecutor(t)
+	)
+
+	executor.ExecFunctionFunc = func(ctx context.Context, _ string, _ execute.Request) (execute.Result, error) {
+		close(started)
+		<-ctx.Done()
+		return execute.Result{Code: codes.Error}, ctx.Err()
+	}
+
+	ce := newCancelableExecutor(executor)
+
+	t.Run("cancel of unknown execution", func(t *testing.T) {
+		require.False(t, ce.cancel(requestID))
+	})
+	t.Run("running execution is canceled", func(t *testing.T) {
+
+		type outcome struct {
+			res execute.Result
+			err error
+		}
+
+		done := make(chan outcome)
+		go func() {
+			res, err := ce.ExecuteFunction(context.Background(), requestID, mocks.GenericExecutionRequest)
+			done <- outcome{res: res, err: err}
+		}()
+
+		<-started
+		require.True(t, ce.cancel(requestID))
+
+		out := <-done
+		require.ErrorIs(t, out.err, blockless.ErrExecutionCanceled)
+		require.Equal(t, codes.Canceled, out.res.Code)
+
+		// Execution is no longer tracked.
+		require.False(t, ce.cancel(requestID))
+	})
+}
+
+func TestWorker_ProcessCancelWorkOrder(t *testing.T) {
+
+	var (
+		requester = mocks.GenericPeerIDs[0]
+		other     = mocks.GenericPeerIDs[1]
+
+		req = request.WorkOrder{
+			RequestID: "dummy-request-id",
+			Request:   mocks.GenericExecutionRequest,
+		}
+
+		started = make(chan struct{})
+	)
+
+	executor := mocks.BaselineExecutor(t)
+	executor.ExecFunctionFunc = func(ctx context.Context, _ string, _ execute.Request) (execute.Result, error) {
+		close(started)
+		<-ctx.Done()
+		return execute.Result{Code: codes.Error}, ctx.Err()
+	}
+
+	worker := createWorkerNode(t)
+	worker.executor = newCancelableExecutor(executor)
+
+	done := make(chan error)
+	go func() {
+		done <- worker.processWorkOrder(context.Background(), requester, req)
+	}()
+
+	<-started
+
+	cancel := request.CancelWorkOrder{RequestID: req.RequestID}
+
+	// Cancellation from a node that did not request the work is ignored.
+	err := worker.processCancelWorkOrder(context.Background(), other, cancel)
+	require.Error(t, err)
+	require.Equal(t, uint(1), worker.executor.count())
+
+	err = worker.processCancelWorkOrder(context.Background(), requester, cancel)
+	require.NoError(t, err)
+
+	require.NoError(t, <-done)
+	require.Zero(t, worker.executor.count())
+
+	// Requester is forgotten once the work is done.
+	_, ok := worker.requesters.Get(req.RequestID)
+	require.False(t, ok)
+}
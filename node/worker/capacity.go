@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"github.com/blocklessnetwork/b7s/models/execute"
+)
+
+// capacity returns the current capacity and load of the node.
+func (w *Worker) capacity() execute.NodeCapacity {
+
+	running := w.executor.count()
+
+	capacity := execute.NodeCapacity{
+		RunningExecutions: running,
+		RuntimeVersion:    w.cfg.RuntimeVersion,
+	}
+
+	if running < w.cfg.Concurrency {
+		capacity.FreeSlots = w.cfg.Concurrency - running
+	}
+
+	cpu, err := cpuPressure()
+	if err != nil {
+		w.Log().Debug().Err(err).Msg("could not determine CPU pressure")
+	}
+	capacity.CPUPressure = cpu
+
+	memory, err := memoryPressure()
+	if err != nil {
+		w.Log().Debug().Err(err).Msg("could not determine memory pressure")
+	}
+	capacity.MemoryPressure = memory
+
+	return capacity
+}
@@ -41,6 +41,9 @@ func (w *Worker) processFormCluster(ctx context.Context, from peer.ID, req reque
 		w.Host().Network().Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, ClusterAddressTTL)
 	}
 
+	// Only the node that formed the cluster may cancel the work.
+	w.requesters.Set(req.RequestID, from)
+
 	switch req.Consensus {
 	case consensus.Raft:
 		return w.createRaftCluster(ctx, from, req)
@@ -102,6 +105,7 @@ func (w *Worker) leaveCluster(requestID string, timeout time.Duration) error {
 	}
 
 	w.clusters.Delete(requestID)
+	w.requesters.Delete(requestID)
 
 	return nil
 }
@@ -3,10 +3,13 @@ package worker
 import (
 	"errors"
 	"path/filepath"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blocklessnetwork/b7s/metadata"
+	"github.com/blocklessnetwork/b7s/models/blockless"
 )
 
 // Option can be used to set Node configuration options.
@@ -14,15 +17,25 @@ type Option func(*Config)
 
 // DefaultConfig represents the default settings for the node.
 var DefaultConfig = Config{
-	LoadAttributes:   DefaultAttributeLoadingSetting,
-	MetadataProvider: metadata.NewNoopProvider(),
+	LoadAttributes:    DefaultAttributeLoadingSetting,
+	AttributeGateways: DefaultAttributeGateways,
+	AttributeTimeout:  DefaultAttributeTimeout,
+	MetadataProvider:  metadata.NewNoopProvider(),
+	Concurrency:       blockless.DefaultConcurrency,
 }
 
 // Config represents the Node configuration.
 type Config struct {
-	Workspace        string            // Directory where we can store files needed for execution.
-	LoadAttributes   bool              // Node should try to load its attributes from IPFS.
-	MetadataProvider metadata.Provider // Metadata provider for the node
+	Workspace          string            // Directory where we can store files needed for execution.
+	LoadAttributes     bool              // Node should try to load its attributes from IPFS.
+	AttributeFile      string            // Local attestation file to load attributes from, instead of IPFS.
+	AttributeGateways  []string          // IPFS gateways to load attributes from, tried in order.
+	AttributeTimeout   time.Duration     // How long can loading attributes from a single gateway take.
+	DeclaredAttributes map[string]string // Self-declared attributes, used if no attestation file is specified.
+	MetadataProvider   metadata.Provider // Metadata provider for the node
+	Concurrency        uint              // How many executions can the node run in parallel.
+	RuntimeVersion     string            // Version of the Blockless Runtime, advertised to the head nodes.
+	TrustedHeads       []peer.ID         // Head nodes allowed to uninstall functions from the node.
 }
 
 // Validate checks if the given configuration is correct.
@@ -34,6 +47,14 @@ func (c Config) Valid() error {
 		err = multierror.Append(err, errors.New("workspace must be an absolute path"))
 	}
 
+	if c.Concurrency == 0 {
+		err = multierror.Append(err, errors.New("concurrency must be positive"))
+	}
+
+	if c.LoadAttributes && len(c.AttributeGateways) == 0 {
+		err = multierror.Append(err, errors.New("at least one gateway is required for loading attributes"))
+	}
+
 	return err.ErrorOrNil()
 }
 
@@ -51,9 +72,60 @@ func AttributeLoading(b bool) Option {
 	}
 }
 
+// AttributeFile sets the local attestation file the node loads its attributes from.
+func AttributeFile(path string) Option {
+	return func(cfg *Config) {
+		cfg.AttributeFile = path
+	}
+}
+
+// AttributeGateways sets the IPFS gateways the node loads its attributes from. Gateways are tried in order until one succeeds.
+// Gateway can include the `{name}` placeholder for the IPNS name (e.g. `https://{name}.ipns.example.com`),
+// otherwise the path format is used (e.g. `https://example.com/ipns/<name>`).
+func AttributeGateways(gateways []string) Option {
+	return func(cfg *Config) {
+		cfg.AttributeGateways = gateways
+	}
+}
+
+// AttributeTimeout sets how long can loading attributes from a single gateway take.
+func AttributeTimeout(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.AttributeTimeout = d
+	}
+}
+
+// DeclaredAttributes sets the attributes the node declares for itself. These attributes are not attested.
+func DeclaredAttributes(attrs map[string]string) Option {
+	return func(cfg *Config) {
+		cfg.DeclaredAttributes = attrs
+	}
+}
+
 // MetadataProvider sets the metadata provider for the node.
 func MetadataProvider(p metadata.Provider) Option {
 	return func(cfg *Config) {
 		cfg.MetadataProvider = p
 	}
 }
+
+// Concurrency sets how many executions the node can run in parallel. Roll calls are declined when the node is at capacity.
+func Concurrency(n uint) Option {
+	return func(cfg *Config) {
+		cfg.Concurrency = n
+	}
+}
+
+// RuntimeVersion sets the Blockless Runtime version the node advertises to head nodes.
+func RuntimeVersion(v string) Option {
+	return func(cfg *Config) {
+		cfg.RuntimeVersion = v
+	}
+}
+
+// TrustedHeads sets the head nodes allowed to uninstall functions from the node. Uninstall requests from other peers are refused.
+func TrustedHeads(ids ...peer.ID) Option {
+	return func(cfg *Config) {
+		cfg.TrustedHeads = ids
+	}
+}
@@ -39,6 +39,12 @@ func (w *Worker) createRaftCluster(ctx context.Context, from peer.ID, fc request
 		}
 		res.Metadata = metadata
 
+		// Sign the result so the head node can verify it came from us.
+		err = res.Sign(w.Host().PrivateKey())
+		if err != nil {
+			w.Log().Warn().Err(err).Msg("could not sign execution result")
+		}
+
 		msg := response.WorkOrder{
 			Code:      res.Code,
 			RequestID: fc.RequestID,
@@ -12,6 +12,15 @@ type FStore interface {
 	// IsInstalled returns info if the function is installed or not.
 	IsInstalled(cid string) (bool, error)
 
+	// Uninstall will remove the function and its files.
+	Uninstall(ctx context.Context, cid string) error
+
+	// Pin marks the function as being in use, protecting it from eviction until the returned function is called.
+	Pin(cid string) func()
+
+	// RunCollector evicts least recently used functions when the workspace is over its disk quota. It blocks until the context is canceled.
+	RunCollector(ctx context.Context)
+
 	// TODO: Refactor the sync code - move the logic outside of the package
 	// Sync will ensure function installations are correct, redownloading functions if needed.
 	Sync(ctx context.Context, haltOnError bool) error
@@ -15,6 +15,17 @@ func (w *Worker) processInstallFunction(ctx context.Context, from peer.ID, req r
 	// Install function.
 	err := w.installFunction(ctx, req.CID, req.ManifestURL)
 	if err != nil {
+
+		// Let the caller know why the install failed.
+		res := req.Response(codes.Error)
+		res.Message = err.Error()
+
+		sendErr := w.Send(ctx, from, res)
+		if sendErr != nil {
+			// Log send error but choose to return the original error.
+			w.Log().Error().Err(sendErr).Stringer("to", from).Msg("could not send response")
+		}
+
 		return fmt.Errorf("could not install function: %w", err)
 	}
 
@@ -6,6 +6,7 @@ import (
 
 const (
 	DefaultAttributeLoadingSetting = false
+	DefaultAttributeTimeout        = 10 * time.Second
 
 	ClusterAddressTTL = 30 * time.Minute
 
@@ -14,6 +15,12 @@ const (
 	syncInterval = time.Hour // How often do we recheck function installations.
 )
 
+// DefaultAttributeGateways are the IPFS gateways used for loading node attributes.
+var DefaultAttributeGateways = []string{
+	"https://{name}.ipns.cf-ipfs.com",
+	"https://ipfs.io",
+}
+
 // Raft and consensus related parameters.
 const (
 	// When disbanding a cluster, how long do we wait until a potential execution is done.
@@ -0,0 +1,78 @@
+//go:build linux
+// +build linux
+
+package worker
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// cpuPressure returns the one minute load average, relative to the number of CPUs.
+func cpuPressure() (float64, error) {
+
+	data, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0, fmt.Errorf("could not read load average: %w", err)
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("unexpected load average format: %s", data)
+	}
+
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse load average: %w", err)
+	}
+
+	return load / float64(runtime.NumCPU()), nil
+}
+
+// memoryPressure returns the portion of the system memory in use.
+func memoryPressure() (float64, error) {
+
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, fmt.Errorf("could not open memory info: %w", err)
+	}
+	defer f.Close()
+
+	var total, available float64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+
+		// Lines are in the form of "MemTotal:       16316412 kB".
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "MemTotal:":
+			total, err = strconv.ParseFloat(fields[1], 64)
+		case "MemAvailable:":
+			available, err = strconv.ParseFloat(fields[1], 64)
+		default:
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("could not parse memory info: %w", err)
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return 0, fmt.Errorf("could not read memory info: %w", err)
+	}
+
+	if total == 0 {
+		return 0, fmt.Errorf("total memory not found")
+	}
+
+	return (total - available) / total, nil
+}
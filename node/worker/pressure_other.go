@@ -0,0 +1,18 @@
+//go:build !linux
+// +build !linux
+
+package worker
+
+import (
+	"errors"
+)
+
+// cpuPressure is not supported on this platform.
+func cpuPressure() (float64, error) {
+	return 0, errors.New("CPU pressure not supported on this platform")
+}
+
+// memoryPressure is not supported on this platform.
+func memoryPressure() (float64, error) {
+	return 0, errors.New("memory pressure not supported on this platform")
+}
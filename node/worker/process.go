@@ -17,6 +17,8 @@ func (w *Worker) process(ctx context.Context, from peer.ID, msg string, payload
 		return node.HandleMessage(ctx, from, payload, w.processHealthCheck)
 	case blockless.MessageInstallFunction:
 		return node.HandleMessage(ctx, from, payload, w.processInstallFunction)
+	case blockless.MessageUninstallFunction:
+		return node.HandleMessage(ctx, from, payload, w.processUninstallFunction)
 	case blockless.MessageRollCall:
 		return node.HandleMessage(ctx, from, payload, w.processRollCall)
 	case blockless.MessageWorkOrder:
@@ -25,6 +27,8 @@ func (w *Worker) process(ctx context.Context, from peer.ID, msg string, payload
 		return node.HandleMessage(ctx, from, payload, w.processFormCluster)
 	case blockless.MessageDisbandCluster:
 		return node.HandleMessage(ctx, from, payload, w.processDisbandCluster)
+	case blockless.MessageCancelWorkOrder:
+		return node.HandleMessage(ctx, from, payload, w.processCancelWorkOrder)
 	}
 
 	return fmt.Errorf("unsupported message: %s", msg)
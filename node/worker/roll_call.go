@@ -29,6 +29,20 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 		return nil
 	}
 
+	// Decline the roll call if we're already running as many executions as we can.
+	capacity := w.capacity()
+	if capacity.FreeSlots == 0 {
+
+		log.Info().Uint("running", capacity.RunningExecutions).Msg("declining roll call - node at capacity")
+
+		err := w.Send(ctx, from, req.Response(codes.NotAvailable).WithCapacity(capacity))
+		if err != nil {
+			return fmt.Errorf("could not send response: %w", err)
+		}
+
+		return nil
+	}
+
 	if req.Attributes != nil {
 
 		if w.attributes == nil {
@@ -77,7 +91,7 @@ func (w *Worker) processRollCall(ctx context.Context, from peer.ID, req request.
 	w.Metrics().IncrCounterWithLabels(rollCallsAppliedMetric, 1, []metrics.Label{{Name: "function", Value: req.FunctionID}})
 
 	// Send positive response.
-	err = w.Send(ctx, from, req.Response(codes.Accepted))
+	err = w.Send(ctx, from, req.Response(codes.Accepted).WithCapacity(capacity))
 	if err != nil {
 		return fmt.Errorf("could not send response: %w", err)
 	}
@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessRollCall_Capacity(t *testing.T) {
+
+	const (
+		concurrency = 2
+		version     = "v0.0.1"
+	)
+
+	req := request.RollCall{
+		RequestID:  "dummy-request-id",
+		FunctionID: mocks.GenericFunctionRecord.CID,
+	}
+
+	// Create a worker that records the roll call response.
+	setup := func(t *testing.T) (*Worker, *response.RollCall) {
+		t.Helper()
+
+		var res response.RollCall
+
+		core := mocks.BaselineNodeCore(t)
+		core.SendFunc = func(_ context.Context, _ peer.ID, msg blockless.Message) error {
+			rc, ok := any(msg).(*response.RollCall)
+			require.True(t, ok)
+
+			res = *rc
+			return nil
+		}
+
+		worker, err := New(core, mocks.BaselineFStore(t), mocks.BaselineExecutor(t),
+			Workspace(t.TempDir()),
+			Concurrency(concurrency),
+			RuntimeVersion(version),
+		)
+		require.NoError(t, err)
+
+		return worker, &res
+	}
+
+	t.Run("capacity is advertised", func(t *testing.T) {
+
+		worker, res := setup(t)
+		worker.executor.running.Set("running-request", func(error) {})
+
+		err := worker.processRollCall(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.Accepted, res.Code)
+		require.NotNil(t, res.Capacity)
+		require.Equal(t, uint(1), res.Capacity.RunningExecutions)
+		require.Equal(t, uint(1), res.Capacity.FreeSlots)
+		require.Equal(t, version, res.Capacity.RuntimeVersion)
+	})
+	t.Run("roll call declined at capacity", func(t *testing.T) {
+
+		worker, res := setup(t)
+		for _, id := range []string{"request-1", "request-2"} {
+			worker.executor.running.Set(id, func(error) {})
+		}
+
+		err := worker.processRollCall(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.NotAvailable, res.Code)
+		require.NotNil(t, res.Capacity)
+		require.Zero(t, res.Capacity.FreeSlots)
+	})
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/request"
+)
+
+// processUninstallFunction removes the function from the node. Unlike installs, which at worst cost some disk space,
+// removing functions disrupts executions the node takes part in, so only trusted head nodes may request it.
+func (w *Worker) processUninstallFunction(ctx context.Context, from peer.ID, req request.UninstallFunction) error {
+
+	if !slices.Contains(w.cfg.TrustedHeads, from) {
+
+		w.Log().Warn().Stringer("from", from).Str("cid", req.CID).Msg("refusing function uninstall requested by an untrusted peer")
+
+		res := req.Response(codes.NotPermitted)
+		res.Message = "peer not allowed to uninstall functions"
+
+		err := w.Send(ctx, from, res)
+		if err != nil {
+			return fmt.Errorf("could not send the response (peer: %s): %w", from, err)
+		}
+
+		return nil
+	}
+
+	err := w.fstore.Uninstall(ctx, req.CID)
+	if err != nil {
+
+		// Function that is not installed is reported to the caller, but is not an error on our end.
+		code := codes.Error
+		if errors.Is(err, blockless.ErrNotFound) {
+			code = codes.NotFound
+		}
+
+		// Let the caller know why the uninstall failed.
+		res := req.Response(code)
+		res.Message = err.Error()
+
+		sendErr := w.Send(ctx, from, res)
+		if sendErr != nil {
+			// Log send error but choose to return the original error.
+			w.Log().Error().Err(sendErr).Stringer("to", from).Msg("could not send response")
+		}
+
+		if code == codes.NotFound {
+			return nil
+		}
+
+		return fmt.Errorf("could not uninstall function: %w", err)
+	}
+
+	// Reply to the caller.
+	err = w.Send(ctx, from, req.Response(codes.OK))
+	if err != nil {
+		return fmt.Errorf("could not send the response (peer: %s): %w", from, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
+	"github.com/blocklessnetwork/b7s/models/codes"
+	"github.com/blocklessnetwork/b7s/models/request"
+	"github.com/blocklessnetwork/b7s/models/response"
+	"github.com/blocklessnetwork/b7s/testing/mocks"
+)
+
+func TestWorker_ProcessUninstallFunction(t *testing.T) {
+
+	req := request.UninstallFunction{
+		CID: mocks.GenericFunctionRecord.CID,
+	}
+
+	// Create a worker that records the uninstall response.
+	setup := func(t *testing.T, uninstall func(context.Context, string) error) (*Worker, *response.UninstallFunction) {
+		t.Helper()
+
+		var res response.UninstallFunction
+
+		core := mocks.BaselineNodeCore(t)
+		core.SendFunc = func(_ context.Context, to peer.ID, msg blockless.Message) error {
+			require.Equal(t, mocks.GenericPeerID, to)
+
+			ur, ok := any(msg).(*response.UninstallFunction)
+			require.True(t, ok)
+
+			res = *ur
+			return nil
+		}
+
+		fstore := mocks.BaselineFStore(t)
+		fstore.UninstallFunc = uninstall
+
+		worker := createWorkerNode(t)
+		worker.Core = core
+		worker.fstore = fstore
+		worker.cfg.TrustedHeads = []peer.ID{mocks.GenericPeerID}
+
+		return worker, &res
+	}
+
+	t.Run("function uninstalled", func(t *testing.T) {
+
+		worker, res := setup(t, func(_ context.Context, cid string) error {
+			require.Equal(t, req.CID, cid)
+			return nil
+		})
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.OK, res.Code)
+		require.Equal(t, req.CID, res.CID)
+	})
+	t.Run("function not installed", func(t *testing.T) {
+
+		worker, res := setup(t, func(context.Context, string) error {
+			return fmt.Errorf("could not retrieve function record: %w", blockless.ErrNotFound)
+		})
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.NotFound, res.Code)
+		require.Equal(t, req.CID, res.CID)
+	})
+	t.Run("uninstall failure is reported", func(t *testing.T) {
+
+		worker, res := setup(t, func(context.Context, string) error {
+			return mocks.GenericError
+		})
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.ErrorIs(t, err, mocks.GenericError)
+
+		require.Equal(t, codes.Error, res.Code)
+		require.Equal(t, req.CID, res.CID)
+		require.Contains(t, res.Message, mocks.GenericError.Error())
+	})
+	t.Run("request from untrusted peer is refused", func(t *testing.T) {
+
+		worker, res := setup(t, func(context.Context, string) error {
+			require.FailNow(t, "function should not be uninstalled")
+			return nil
+		})
+		worker.cfg.TrustedHeads = nil
+
+		err := worker.processUninstallFunction(context.Background(), mocks.GenericPeerID, req)
+		require.NoError(t, err)
+
+		require.Equal(t, codes.NotPermitted, res.Code)
+		require.Equal(t, req.CID, res.CID)
+	})
+}
@@ -31,6 +31,15 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 
 	log := w.Log().With().Str("request", requestID).Str("function", req.FunctionID).Logger()
 
+	// Remember who requested the work, so only they can cancel it. For clustered executions, this is cleared when the node leaves the cluster.
+	w.requesters.Set(requestID, from)
+	defer func() {
+		_, ok := w.clusters.Get(requestID)
+		if !ok {
+			w.requesters.Delete(requestID)
+		}
+	}()
+
 	// NOTE: In case of an error, we do not return early from this function.
 	// Instead, we send the response back to the caller, whatever it may be.
 	code, result, err := w.execute(ctx, requestID, req.Timestamp, req.Request, from)
@@ -57,6 +66,12 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 	// Prepare a work order response.
 	res := req.Response(code, result).WithMetadata(metadata)
 
+	// Sign the result so the head node can verify it came from us.
+	err = res.Result.Sign(w.Host().PrivateKey())
+	if err != nil {
+		log.Error().Err(err).Msg("could not sign execution result")
+	}
+
 	log.Info().Stringer("code", code).Msg("execution complete")
 
 	// Send the response, whatever it may be (success or failure).
@@ -70,6 +85,10 @@ func (w *Worker) processWorkOrder(ctx context.Context, from peer.ID, req request
 
 func (w *Worker) execute(ctx context.Context, requestID string, timestamp time.Time, req execute.Request, from peer.ID) (codes.Code, execute.Result, error) {
 
+	// Make sure the function is not evicted while we're using it.
+	release := w.fstore.Pin(req.FunctionID)
+	defer release()
+
 	// Check if we have function in store.
 	functionInstalled, err := w.fstore.IsInstalled(req.FunctionID)
 	if err != nil {
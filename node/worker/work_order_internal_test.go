@@ -83,11 +83,14 @@ func TestWorker_ProcessWorkOrder(t *testing.T) {
 		require.Equal(t, result.Result, er.Result.Result.Result) // RuntimeOutput
 		require.Equal(t, result.Usage, er.Result.Result.Usage)
 
+		// Result is signed with the host key.
+		require.NoError(t, er.Result.VerifySignature(core.Host().PrivateKey().GetPublic()))
+
 		return nil
 	}
 
 	worker := createWorkerNode(t)
-	worker.executor = executor
+	worker.executor = newCancelableExecutor(executor)
 	worker.Core = core
 
 	err := worker.processWorkOrder(context.Background(), mocks.GenericPeerID, req)
@@ -140,6 +143,47 @@ func TestWorker_ProcessWorkOrder_Metadata(t *testing.T) {
 
 }
 
+func TestWorker_ProcessWorkOrder_PinsFunction(t *testing.T) {
+
+	var (
+		req = request.WorkOrder{
+			RequestID: "request-id",
+			Request:   mocks.GenericExecutionRequest,
+		}
+
+		pinned   bool
+		released bool
+	)
+
+	// Function must be pinned for the duration of the execution.
+	fstore := mocks.BaselineFStore(t)
+	fstore.PinFunc = func(cid string) func() {
+		require.Equal(t, req.FunctionID, cid)
+		pinned = true
+
+		return func() {
+			released = true
+		}
+	}
+
+	executor := mocks.BaselineExecutor(t)
+	executor.ExecFunctionFunc = func(context.Context, string, execute.Request) (execute.Result, error) {
+		require.True(t, pinned)
+		require.False(t, released)
+
+		return mocks.GenericExecutionResult, nil
+	}
+
+	worker := createWorkerNode(t)
+	worker.fstore = fstore
+	worker.executor = newCancelableExecutor(executor)
+
+	err := worker.processWorkOrder(context.Background(), mocks.GenericPeerID, req)
+	require.NoError(t, err)
+
+	require.True(t, released)
+}
+
 func TestWorker_ProcessWorkOrder_HandlesErrors(t *testing.T) {
 
 	t.Run("function lookup error", func(t *testing.T) {
@@ -243,7 +287,7 @@ func TestWorker_ProcessWorkOrder_HandlesErrors(t *testing.T) {
 		executor.ExecFunctionFunc = func(_ context.Context, _ string, _ execute.Request) (execute.Result, error) {
 			return result, errors.New("execution error")
 		}
-		worker.executor = executor
+		worker.executor = newCancelableExecutor(executor)
 
 		// Override Send function to verify that the result it is passed to it is what the executor returned, and it was sent despite an execution error.
 		core := mocks.BaselineNodeCore(t)
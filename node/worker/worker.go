@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/blocklessnetwork/b7s-attributes/attributes"
 	"github.com/blocklessnetwork/b7s/info"
@@ -20,12 +21,13 @@ type Worker struct {
 
 	cfg Config
 
-	executor blockless.Executor
+	executor *cancelableExecutor
 	fstore   FStore
 
 	attributes *attributes.Attestation
 
 	clusters         *syncmap.Map[string, consensusExecutor] // clusters maps request ID to the cluster the node belongs to.
+	requesters       *syncmap.Map[string, peer.ID]           // requesters maps request ID to the head node that requested the work.
 	executeResponses *waitmap.WaitMap[string, execute.NodeResult]
 }
 
@@ -47,23 +49,23 @@ func New(core node.Core, fstore FStore, executor blockless.Executor, options ...
 		cfg:  cfg,
 
 		fstore:           fstore,
-		executor:         executor,
+		executor:         newCancelableExecutor(executor),
 		clusters:         syncmap.New[string, consensusExecutor](),
+		requesters:       syncmap.New[string, peer.ID](),
 		executeResponses: waitmap.New[string, execute.NodeResult](1000),
 	}
 
-	if cfg.LoadAttributes {
-
-		attributes, err := loadAttributes(core.Host().PublicKey())
-		if err != nil {
-			return nil, fmt.Errorf("could not load attribute data: %w", err)
-		}
+	attributes, err := loadAttributes(cfg, core.Host().ID(), *core.Log())
+	if err != nil {
+		return nil, fmt.Errorf("could not load attribute data: %w", err)
+	}
+	if attributes != nil {
 
 		core.Log().Info().
 			Any("attributes", attributes).
 			Msg("node loaded attributes")
 
-		worker.attributes = &attributes
+		worker.attributes = attributes
 	}
 
 	worker.Metrics().SetGaugeWithLabels(node.NodeInfoMetric, 1,
@@ -87,5 +89,8 @@ func (w *Worker) Run(ctx context.Context) error {
 	// Start the function sync in the background to periodically check functions.
 	go w.runSyncLoop(ctx)
 
+	// Start evicting unused functions in the background to keep the workspace within its disk quota.
+	go w.fstore.RunCollector(ctx)
+
 	return w.Core.Run(ctx, w.process)
 }
@@ -4,6 +4,7 @@ package store
 func (s *Store) Keys() []string {
 
 	it, _ := s.db.NewIter(nil)
+	defer it.Close()
 
 	var keys []string
 	for it.First(); it.Valid(); it.Next() {
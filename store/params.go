@@ -1,8 +1,12 @@
 package store
 
 const (
-	PrefixPeer     = 1
-	PrefixFunction = 2
+	PrefixPeer            = 1
+	PrefixFunction        = 2
+	PrefixExecutionResult = 3
+	PrefixSchedule        = 4
+	PrefixWorkflow        = 5
+	PrefixReputation      = 6
 )
 
 const (
@@ -35,6 +35,28 @@ func (s *Store) RemoveFunction(_ context.Context, cid string) error {
 	return nil
 }
 
+func (s *Store) RemoveExecutionResult(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixExecutionResult, id)
+	err := s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove execution result: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) RemoveSchedule(_ context.Context, id string) error {
+
+	key := encodeKey(PrefixSchedule, id)
+	err := s.remove(key)
+	if err != nil {
+		return fmt.Errorf("could not remove schedule: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Store) remove(key []byte) error {
 	return s.db.Delete(key, pebble.Sync)
 }
@@ -32,20 +32,19 @@ func (s *Store) RetrievePeers(_ context.Context) ([]blockless.Peer, error) {
 
 	peers := make([]blockless.Peer, 0)
 
-	opts := prefixIterOptions([]byte{PrefixPeer})
-	it, err := s.db.NewIter(opts)
-	if err != nil {
-		return nil, fmt.Errorf("could not create iterator: %w", err)
-	}
-	for it.First(); it.Valid(); it.Next() {
+	err := s.iterate([]byte{PrefixPeer}, func(key []byte) error {
 
 		var peer blockless.Peer
-		err := s.retrieve(it.Key(), &peer)
+		err := s.retrieve(key, &peer)
 		if err != nil {
-			return nil, fmt.Errorf("could not retrieve peer (key: %x): %w", it.Key(), err)
+			return fmt.Errorf("could not retrieve peer (key: %x): %w", key, err)
 		}
 
 		peers = append(peers, peer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return peers, nil
@@ -67,25 +66,165 @@ func (s *Store) RetrieveFunctions(_ context.Context) ([]blockless.FunctionRecord
 
 	functions := make([]blockless.FunctionRecord, 0)
 
-	opts := prefixIterOptions([]byte{PrefixFunction})
-	it, err := s.db.NewIter(opts)
-	if err != nil {
-		return nil, fmt.Errorf("could not create iterator: %w", err)
-	}
-	for it.First(); it.Valid(); it.Next() {
+	err := s.iterate([]byte{PrefixFunction}, func(key []byte) error {
 
 		var function blockless.FunctionRecord
-		err := s.retrieve(it.Key(), &function)
+		err := s.retrieve(key, &function)
 		if err != nil {
-			return nil, fmt.Errorf("could not retrieve functioN (key: %x): %w", it.Key(), err)
+			return fmt.Errorf("could not retrieve function (key: %x): %w", key, err)
 		}
 
 		functions = append(functions, function)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return functions, nil
 }
 
+func (s *Store) RetrieveExecutionResult(_ context.Context, id string) (blockless.ExecutionRecord, error) {
+
+	key := encodeKey(PrefixExecutionResult, id)
+	var record blockless.ExecutionRecord
+	err := s.retrieve(key, &record)
+	if err != nil {
+		return blockless.ExecutionRecord{}, fmt.Errorf("could not retrieve execution result: %w", err)
+	}
+
+	return record, nil
+}
+
+func (s *Store) RetrieveExecutionResults(_ context.Context) ([]blockless.ExecutionRecord, error) {
+
+	records := make([]blockless.ExecutionRecord, 0)
+
+	err := s.iterate([]byte{PrefixExecutionResult}, func(key []byte) error {
+
+		var record blockless.ExecutionRecord
+		err := s.retrieve(key, &record)
+		if err != nil {
+			return fmt.Errorf("could not retrieve execution result (key: %x): %w", key, err)
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (s *Store) RetrieveSchedule(_ context.Context, id string) (blockless.ScheduleRecord, error) {
+
+	key := encodeKey(PrefixSchedule, id)
+	var schedule blockless.ScheduleRecord
+	err := s.retrieve(key, &schedule)
+	if err != nil {
+		return blockless.ScheduleRecord{}, fmt.Errorf("could not retrieve schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *Store) RetrieveSchedules(_ context.Context) ([]blockless.ScheduleRecord, error) {
+
+	schedules := make([]blockless.ScheduleRecord, 0)
+
+	err := s.iterate([]byte{PrefixSchedule}, func(key []byte) error {
+
+		var schedule blockless.ScheduleRecord
+		err := s.retrieve(key, &schedule)
+		if err != nil {
+			return fmt.Errorf("could not retrieve schedule (key: %x): %w", key, err)
+		}
+
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (s *Store) RetrieveWorkflow(_ context.Context, id string) (blockless.WorkflowRecord, error) {
+
+	key := encodeKey(PrefixWorkflow, id)
+	var workflow blockless.WorkflowRecord
+	err := s.retrieve(key, &workflow)
+	if err != nil {
+		return blockless.WorkflowRecord{}, fmt.Errorf("could not retrieve workflow: %w", err)
+	}
+
+	return workflow, nil
+}
+
+func (s *Store) RetrieveWorkflows(_ context.Context) ([]blockless.WorkflowRecord, error) {
+
+	workflows := make([]blockless.WorkflowRecord, 0)
+
+	err := s.iterate([]byte{PrefixWorkflow}, func(key []byte) error {
+
+		var workflow blockless.WorkflowRecord
+		err := s.retrieve(key, &workflow)
+		if err != nil {
+			return fmt.Errorf("could not retrieve workflow (key: %x): %w", key, err)
+		}
+
+		workflows = append(workflows, workflow)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workflows, nil
+}
+
+func (s *Store) RetrieveReputation(_ context.Context, id peer.ID) (blockless.PeerReputation, error) {
+
+	idBytes, err := id.MarshalBinary()
+	if err != nil {
+		return blockless.PeerReputation{}, fmt.Errorf("could not serialize peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixReputation, idBytes)
+	var reputation blockless.PeerReputation
+	err = s.retrieve(key, &reputation)
+	if err != nil {
+		return blockless.PeerReputation{}, fmt.Errorf("could not retrieve peer reputation: %w", err)
+	}
+
+	return reputation, nil
+}
+
+func (s *Store) RetrieveReputations(_ context.Context) ([]blockless.PeerReputation, error) {
+
+	reputations := make([]blockless.PeerReputation, 0)
+
+	err := s.iterate([]byte{PrefixReputation}, func(key []byte) error {
+
+		var reputation blockless.PeerReputation
+		err := s.retrieve(key, &reputation)
+		if err != nil {
+			return fmt.Errorf("could not retrieve peer reputation (key: %x): %w", key, err)
+		}
+
+		reputations = append(reputations, reputation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reputations, nil
+}
+
 func (s *Store) retrieve(key []byte, out any) error {
 
 	value, closer, err := s.db.Get(key)
@@ -106,6 +245,31 @@ func (s *Store) retrieve(key []byte, out any) error {
 	return nil
 }
 
+// iterate calls the function for each key with the given prefix.
+func (s *Store) iterate(prefix []byte, fn func(key []byte) error) (retErr error) {
+
+	it, err := s.db.NewIter(prefixIterOptions(prefix))
+	if err != nil {
+		return fmt.Errorf("could not create iterator: %w", err)
+	}
+	// Iterator must be closed else a memory leak occurs.
+	defer func() {
+		err := it.Close()
+		if err != nil && retErr == nil {
+			retErr = fmt.Errorf("could not close iterator: %w", err)
+		}
+	}()
+
+	for it.First(); it.Valid(); it.Next() {
+		err := fn(it.Key())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func prefixIterOptions(prefix []byte) *pebble.IterOptions {
 	return &pebble.IterOptions{
 		LowerBound: prefix,
@@ -36,6 +36,55 @@ func (s *Store) SaveFunction(_ context.Context, function blockless.FunctionRecor
 	return nil
 }
 
+func (s *Store) SaveExecutionResult(_ context.Context, record blockless.ExecutionRecord) error {
+
+	key := encodeKey(PrefixExecutionResult, record.RequestID)
+	err := s.save(key, record)
+	if err != nil {
+		return fmt.Errorf("could not save execution result: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SaveSchedule(_ context.Context, schedule blockless.ScheduleRecord) error {
+
+	key := encodeKey(PrefixSchedule, schedule.ID)
+	err := s.save(key, schedule)
+	if err != nil {
+		return fmt.Errorf("could not save schedule: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SaveWorkflow(_ context.Context, workflow blockless.WorkflowRecord) error {
+
+	key := encodeKey(PrefixWorkflow, workflow.ID)
+	err := s.save(key, workflow)
+	if err != nil {
+		return fmt.Errorf("could not save workflow: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SaveReputation(_ context.Context, reputation blockless.PeerReputation) error {
+
+	id, err := reputation.Peer.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not serialize peer ID: %w", err)
+	}
+
+	key := encodeKey(PrefixReputation, id)
+	err = s.save(key, reputation)
+	if err != nil {
+		return fmt.Errorf("could not save peer reputation: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Store) save(key []byte, value any) error {
 
 	encoded, err := s.codec.Marshal(value)
@@ -140,6 +140,134 @@ func TestStore_RetrieveFunctions(t *testing.T) {
 	}
 }
 
+func TestStore_ExecutionResultOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	record := mocks.GenericExecutionRecord
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save execution result", func(t *testing.T) {
+		err := store.SaveExecutionResult(ctx, record)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve execution result", func(t *testing.T) {
+		retrieved, err := store.RetrieveExecutionResult(ctx, record.RequestID)
+		require.NoError(t, err)
+
+		require.Equal(t, record, retrieved)
+	})
+	t.Run("retrieve execution results", func(t *testing.T) {
+		retrieved, err := store.RetrieveExecutionResults(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, retrieved, 1)
+		require.Equal(t, record, retrieved[0])
+	})
+	t.Run("remove execution result", func(t *testing.T) {
+		err := store.RemoveExecutionResult(ctx, record.RequestID)
+		require.NoError(t, err)
+
+		// Verify execution result is gone.
+		_, err = store.RetrieveExecutionResult(ctx, record.RequestID)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+}
+
+func TestStore_ScheduleOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	schedule := mocks.GenericScheduleRecord
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save schedule", func(t *testing.T) {
+		err := store.SaveSchedule(ctx, schedule)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve schedule", func(t *testing.T) {
+		retrieved, err := store.RetrieveSchedule(ctx, schedule.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, schedule, retrieved)
+	})
+	t.Run("retrieve schedules", func(t *testing.T) {
+		retrieved, err := store.RetrieveSchedules(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, retrieved, 1)
+		require.Equal(t, schedule, retrieved[0])
+	})
+	t.Run("remove schedule", func(t *testing.T) {
+		err := store.RemoveSchedule(ctx, schedule.ID)
+		require.NoError(t, err)
+
+		// Verify schedule is gone.
+		_, err = store.RetrieveSchedule(ctx, schedule.ID)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+}
+
+func TestStore_WorkflowOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	workflow := mocks.GenericWorkflowRecord
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("save workflow", func(t *testing.T) {
+		err := store.SaveWorkflow(ctx, workflow)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve workflow", func(t *testing.T) {
+		retrieved, err := store.RetrieveWorkflow(ctx, workflow.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, workflow, retrieved)
+	})
+	t.Run("retrieve workflows", func(t *testing.T) {
+		retrieved, err := store.RetrieveWorkflows(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, retrieved, 1)
+		require.Equal(t, workflow, retrieved[0])
+	})
+}
+
+func TestStore_ReputationOperations(t *testing.T) {
+	db := helpers.InMemoryDB(t)
+	defer db.Close()
+
+	reputation := mocks.GenericPeerReputation
+	store := store.New(db, codec.NewJSONCodec())
+	ctx := context.Background()
+
+	t.Run("missing reputation", func(t *testing.T) {
+		_, err := store.RetrieveReputation(ctx, reputation.Peer)
+		require.ErrorIs(t, err, blockless.ErrNotFound)
+	})
+	t.Run("save reputation", func(t *testing.T) {
+		err := store.SaveReputation(ctx, reputation)
+		require.NoError(t, err)
+	})
+	t.Run("retrieve reputation", func(t *testing.T) {
+		retrieved, err := store.RetrieveReputation(ctx, reputation.Peer)
+		require.NoError(t, err)
+
+		require.Equal(t, reputation, retrieved)
+	})
+	t.Run("retrieve reputations", func(t *testing.T) {
+		retrieved, err := store.RetrieveReputations(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, retrieved, 1)
+		require.Equal(t, reputation, retrieved[0])
+	})
+}
+
 func TestStore_HandlesFailures(t *testing.T) {
 
 	db := helpers.InMemoryDB(t)
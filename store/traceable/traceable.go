@@ -123,6 +123,174 @@ func (s *Store) RemoveFunction(ctx context.Context, cid string) error {
 		opts...)
 }
 
+func (s *Store) SaveExecutionResult(ctx context.Context, record blockless.ExecutionRecord) error {
+
+	callback := func() error {
+		return s.store.SaveExecutionResult(ctx, record)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(record.RequestID)))
+	return s.tracer.WithSpanFromContext(ctx, "SaveExecutionResult", callback, opts...)
+}
+
+func (s *Store) RetrieveExecutionResult(ctx context.Context, id string) (blockless.ExecutionRecord, error) {
+
+	var record blockless.ExecutionRecord
+	var err error
+	callback := func() error {
+		record, err = s.store.RetrieveExecutionResult(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(id)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetExecutionResult", callback, opts...)
+	return record, err
+}
+
+func (s *Store) RetrieveExecutionResults(ctx context.Context) ([]blockless.ExecutionRecord, error) {
+
+	var records []blockless.ExecutionRecord
+	var err error
+	callback := func() error {
+		records, err = s.store.RetrieveExecutionResults(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListExecutionResults", callback, storeSpanOptions()...)
+	return records, err
+}
+
+func (s *Store) RemoveExecutionResult(ctx context.Context, id string) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ExecutionRequestID.String(id)))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveExecutionResult",
+		func() error { return s.store.RemoveExecutionResult(ctx, id) },
+		opts...)
+}
+
+func (s *Store) SaveSchedule(ctx context.Context, schedule blockless.ScheduleRecord) error {
+
+	callback := func() error {
+		return s.store.SaveSchedule(ctx, schedule)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ScheduleID.String(schedule.ID)))
+	return s.tracer.WithSpanFromContext(ctx, "SaveSchedule", callback, opts...)
+}
+
+func (s *Store) RetrieveSchedule(ctx context.Context, id string) (blockless.ScheduleRecord, error) {
+
+	var schedule blockless.ScheduleRecord
+	var err error
+	callback := func() error {
+		schedule, err = s.store.RetrieveSchedule(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ScheduleID.String(id)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetSchedule", callback, opts...)
+	return schedule, err
+}
+
+func (s *Store) RetrieveSchedules(ctx context.Context) ([]blockless.ScheduleRecord, error) {
+
+	var schedules []blockless.ScheduleRecord
+	var err error
+	callback := func() error {
+		schedules, err = s.store.RetrieveSchedules(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListSchedules", callback, storeSpanOptions()...)
+	return schedules, err
+}
+
+func (s *Store) RemoveSchedule(ctx context.Context, id string) error {
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.ScheduleID.String(id)))
+	return s.tracer.WithSpanFromContext(
+		ctx,
+		"RemoveSchedule",
+		func() error { return s.store.RemoveSchedule(ctx, id) },
+		opts...)
+}
+
+func (s *Store) SaveWorkflow(ctx context.Context, workflow blockless.WorkflowRecord) error {
+
+	callback := func() error {
+		return s.store.SaveWorkflow(ctx, workflow)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.WorkflowID.String(workflow.ID)))
+	return s.tracer.WithSpanFromContext(ctx, "SaveWorkflow", callback, opts...)
+}
+
+func (s *Store) RetrieveWorkflow(ctx context.Context, id string) (blockless.WorkflowRecord, error) {
+
+	var workflow blockless.WorkflowRecord
+	var err error
+	callback := func() error {
+		workflow, err = s.store.RetrieveWorkflow(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.WorkflowID.String(id)))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetWorkflow", callback, opts...)
+	return workflow, err
+}
+
+func (s *Store) RetrieveWorkflows(ctx context.Context) ([]blockless.WorkflowRecord, error) {
+
+	var workflows []blockless.WorkflowRecord
+	var err error
+	callback := func() error {
+		workflows, err = s.store.RetrieveWorkflows(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListWorkflows", callback, storeSpanOptions()...)
+	return workflows, err
+}
+
+func (s *Store) SaveReputation(ctx context.Context, reputation blockless.PeerReputation) error {
+
+	callback := func() error {
+		return s.store.SaveReputation(ctx, reputation)
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(reputation.Peer.String())))
+	return s.tracer.WithSpanFromContext(ctx, "SaveReputation", callback, opts...)
+}
+
+func (s *Store) RetrieveReputation(ctx context.Context, id peer.ID) (blockless.PeerReputation, error) {
+
+	var reputation blockless.PeerReputation
+	var err error
+	callback := func() error {
+		reputation, err = s.store.RetrieveReputation(ctx, id)
+		return err
+	}
+
+	opts := storeSpanOptions(trace.WithAttributes(b7ssemconv.PeerID.String(id.String())))
+	_ = s.tracer.WithSpanFromContext(ctx, "GetReputation", callback, opts...)
+	return reputation, err
+}
+
+func (s *Store) RetrieveReputations(ctx context.Context) ([]blockless.PeerReputation, error) {
+
+	var reputations []blockless.PeerReputation
+	var err error
+	callback := func() error {
+		reputations, err = s.store.RetrieveReputations(ctx)
+		return err
+	}
+
+	_ = s.tracer.WithSpanFromContext(ctx, "ListReputations", callback, storeSpanOptions()...)
+	return reputations, err
+}
+
 func peerAttributes(peer blockless.Peer) []attribute.KeyValue {
 	return []attribute.KeyValue{
 		b7ssemconv.PeerID.String(peer.ID.String()),
@@ -30,6 +30,11 @@ const (
 	ExecutionRequestID = attribute.Key("execution.request.id")
 )
 
+const (
+	ScheduleID = attribute.Key("schedule.id")
+	WorkflowID = attribute.Key("workflow.id")
+)
+
 const (
 	PeerID         = attribute.Key("peer.id")
 	PeerMultiaddr  = attribute.Key("peer.multiaddr")
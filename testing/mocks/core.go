@@ -29,6 +29,7 @@ type NodeCore struct {
 	SendToManyFunc     func(context.Context, []peer.ID, blockless.Message, bool) error
 	JoinTopicFunc      func(string) error
 	SubscribeFunc      func(context.Context, string) error
+	TopicsFunc         func() []string
 	PublishFunc        func(context.Context, blockless.Message) error
 	PublishToTopicFunc func(context.Context, string, blockless.Message) error
 	TracerFunc         func() *tracing.Tracer
@@ -76,6 +77,9 @@ func BaselineNodeCore(t *testing.T) *NodeCore {
 		SubscribeFunc: func(context.Context, string) error {
 			return nil
 		},
+		TopicsFunc: func() []string {
+			return []string{blockless.DefaultTopic}
+		},
 		PublishFunc: func(context.Context, blockless.Message) error {
 			return nil
 		},
@@ -128,6 +132,10 @@ func (c NodeCore) Subscribe(ctx context.Context, topic string) error {
 	return c.SubscribeFunc(ctx, topic)
 }
 
+func (c NodeCore) Topics() []string {
+	return c.TopicsFunc()
+}
+
 func (c NodeCore) Publish(ctx context.Context, msg blockless.Message) error {
 	return c.PublishFunc(ctx, msg)
 }
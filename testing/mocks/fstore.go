@@ -6,9 +6,12 @@ import (
 )
 
 type FStore struct {
-	InstallFunc     func(context.Context, string, string) error
-	IsInstalledFunc func(string) (bool, error)
-	SyncFunc        func(context.Context, bool) error
+	InstallFunc      func(context.Context, string, string) error
+	IsInstalledFunc  func(string) (bool, error)
+	SyncFunc         func(context.Context, bool) error
+	UninstallFunc    func(context.Context, string) error
+	PinFunc          func(string) func()
+	RunCollectorFunc func(context.Context)
 }
 
 func BaselineFStore(t *testing.T) *FStore {
@@ -24,6 +27,13 @@ func BaselineFStore(t *testing.T) *FStore {
 		SyncFunc: func(context.Context, bool) error {
 			return nil
 		},
+		UninstallFunc: func(context.Context, string) error {
+			return nil
+		},
+		PinFunc: func(string) func() {
+			return func() {}
+		},
+		RunCollectorFunc: func(context.Context) {},
 	}
 
 	return &fh
@@ -40,3 +50,15 @@ func (f *FStore) IsInstalled(cid string) (bool, error) {
 func (f *FStore) Sync(ctx context.Context, haltOnError bool) error {
 	return f.SyncFunc(ctx, haltOnError)
 }
+
+func (f *FStore) Uninstall(ctx context.Context, cid string) error {
+	return f.UninstallFunc(ctx, cid)
+}
+
+func (f *FStore) Pin(cid string) func() {
+	return f.PinFunc(cid)
+}
+
+func (f *FStore) RunCollector(ctx context.Context) {
+	f.RunCollectorFunc(ctx)
+}
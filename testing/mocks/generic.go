@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -110,4 +111,141 @@ var (
 		Archive:  "/var/tmp/archive.tar.gz",
 		Files:    "/var/tmp/files",
 	}
+
+	GenericExecutionRecord = blockless.ExecutionRecord{
+		RequestID:  GenericUUID.String(),
+		FunctionID: "dummy-function-id",
+		Code:       codes.OK,
+		Results:    GenericExecutionResultMap,
+		Cluster: execute.Cluster{
+			Peers: []peer.ID{GenericPeerID},
+		},
+		StartedAt:   time.Unix(1700000000, 0).UTC(),
+		CompletedAt: time.Unix(1700000010, 0).UTC(),
+	}
+
+	GenericScheduleRecord = blockless.ScheduleRecord{
+		ID:        "dummy-schedule-id",
+		Cron:      "*/5 * * * *",
+		Request:   GenericExecutionRequest,
+		CreatedAt: time.Unix(1700000000, 0).UTC(),
+		NextRun:   time.Unix(1700000100, 0).UTC(),
+		History: []blockless.ScheduleRun{
+			{
+				RequestID:   GenericUUID.String(),
+				Code:        codes.OK,
+				StartedAt:   time.Unix(1700000000, 0).UTC(),
+				CompletedAt: time.Unix(1700000010, 0).UTC(),
+			},
+		},
+	}
+
+	GenericWorkflowRecord = blockless.WorkflowRecord{
+		ID: "dummy-workflow-id",
+		Workflow: execute.Workflow{
+			Steps: []execute.WorkflowStep{
+				{
+					ID:         "first",
+					FunctionID: GenericExecutionRequest.FunctionID,
+					Method:     GenericExecutionRequest.Method,
+				},
+			},
+		},
+		State: execute.StateDone,
+		Steps: []blockless.WorkflowStepRecord{
+			{
+				ID:          "first",
+				State:       execute.StepSucceeded,
+				RequestID:   GenericUUID.String(),
+				Code:        codes.OK,
+				Stdout:      GenericExecutionResult.Result.Stdout,
+				StartedAt:   time.Unix(1700000000, 0).UTC(),
+				CompletedAt: time.Unix(1700000010, 0).UTC(),
+			},
+		},
+		StartedAt:   time.Unix(1700000000, 0).UTC(),
+		CompletedAt: time.Unix(1700000010, 0).UTC(),
+	}
+
+	GenericPeerReputation = blockless.PeerReputation{
+		Peer:              GenericPeerID,
+		Succeeded:         8,
+		Failed:            1,
+		TimedOut:          1,
+		Agreed:            7,
+		Disagreed:         1,
+		MeasuredResponses: 9,
+		AverageLatency:    250 * time.Millisecond,
+		UpdatedAt:         time.Unix(1700000000, 0).UTC(),
+	}
+
+	GenericNodeInfo = blockless.NodeInfo{
+		ID:        GenericPeerID.String(),
+		Role:      blockless.HeadNodeLabel,
+		Version:   "dummy-version",
+		Addresses: []string{GenericAddress},
+		Topics:    []string{blockless.DefaultTopic},
+	}
+
+	GenericConnectedPeer = blockless.ConnectedPeer{
+		ID:        GenericPeerID,
+		Addresses: []string{GenericAddress},
+	}
+
+	GenericInstalledFunction = blockless.InstalledFunction{
+		CID: GenericFunctionRecord.CID,
+		Workers: []blockless.FunctionWorker{
+			{
+				Peer:       GenericPeerID,
+				ReportedAt: time.Unix(1700000000, 0).UTC(),
+			},
+		},
+	}
+
+	GenericInstallJob = blockless.FunctionJob{
+		ID:          GenericUUID.String(),
+		Kind:        blockless.JobInstall,
+		CID:         GenericFunctionRecord.CID,
+		ManifestURL: GenericFunctionRecord.URL,
+		Topic:       blockless.DefaultTopic,
+		CreatedAt:   time.Unix(1700000000, 0).UTC(),
+		Succeeded:   1,
+		Failed:      1,
+		Peers: []blockless.PeerJobStatus{
+			{
+				Peer:      GenericPeerIDs[0],
+				State:     blockless.JobSucceeded,
+				UpdatedAt: time.Unix(1700000010, 0).UTC(),
+			},
+			{
+				Peer:      GenericPeerIDs[1],
+				State:     blockless.JobFailed,
+				Error:     "could not download function",
+				UpdatedAt: time.Unix(1700000010, 0).UTC(),
+			},
+		},
+	}
+
+	GenericUninstallJob = blockless.FunctionJob{
+		ID:        GenericUUID.String(),
+		Kind:      blockless.JobUninstall,
+		CID:       GenericFunctionRecord.CID,
+		Topic:     blockless.DefaultTopic,
+		CreatedAt: time.Unix(1700000000, 0).UTC(),
+		Succeeded: 1,
+		Failed:    1,
+		Peers: []blockless.PeerJobStatus{
+			{
+				Peer:      GenericPeerIDs[0],
+				State:     blockless.JobSucceeded,
+				UpdatedAt: time.Unix(1700000010, 0).UTC(),
+			},
+			{
+				Peer:      GenericPeerIDs[1],
+				State:     blockless.JobFailed,
+				Error:     "function in use",
+				UpdatedAt: time.Unix(1700000010, 0).UTC(),
+			},
+		},
+	}
 )
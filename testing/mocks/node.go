@@ -4,15 +4,39 @@ import (
 	"context"
 	"testing"
 
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/blocklessnetwork/b7s/models/blockless"
 	"github.com/blocklessnetwork/b7s/models/codes"
 	"github.com/blocklessnetwork/b7s/models/execute"
 )
 
 // APINode implements the `Node` interface expected by the API.
 type APINode struct {
-	ExecuteFunctionFunc        func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
-	ExecutionResultFunc        func(id string) (execute.ResultMap, bool)
-	PublishFunctionInstallFunc func(ctx context.Context, uri string, cid string, subgroup string) error
+	ExecuteFunctionFunc          func(context.Context, execute.Request, string) (codes.Code, string, execute.ResultMap, execute.Cluster, error)
+	ExecuteFunctionAsyncFunc     func(context.Context, execute.Request, string) (string, error)
+	ExecuteFunctionStreamFunc    func(context.Context, execute.Request, string) (string, <-chan execute.Event, error)
+	ExecuteBatchFunc             func(context.Context, execute.BatchRequest, string) (codes.Code, string, []execute.BatchItemResult, error)
+	ExecuteMapReduceFunc         func(context.Context, execute.MapReduceRequest, string) (codes.Code, string, execute.MapReduceResult, error)
+	ExecutionResultFunc          func(context.Context, string) (blockless.ExecutionRecord, error)
+	ExecutionStatusFunc          func(context.Context, string) (execute.State, error)
+	CancelExecutionFunc          func(context.Context, string) error
+	PublishFunctionInstallFunc   func(ctx context.Context, uri string, cid string, subgroup string) (blockless.FunctionJob, error)
+	InstallStatusFunc            func(context.Context, string) (blockless.FunctionJob, error)
+	PublishFunctionUninstallFunc func(context.Context, string, string) (blockless.FunctionJob, error)
+	UninstallStatusFunc          func(context.Context, string) (blockless.FunctionJob, error)
+	CreateScheduleFunc           func(context.Context, string, execute.Request, string) (blockless.ScheduleRecord, error)
+	ScheduleFunc                 func(context.Context, string) (blockless.ScheduleRecord, error)
+	SchedulesFunc                func(context.Context) ([]blockless.ScheduleRecord, error)
+	RemoveScheduleFunc           func(context.Context, string) error
+	ExecuteWorkflowFunc          func(context.Context, execute.Workflow, string) (string, error)
+	WorkflowFunc                 func(context.Context, string) (blockless.WorkflowRecord, error)
+	PeerReputationFunc           func(context.Context, peer.ID) (blockless.PeerReputation, error)
+	PeerReputationsFunc          func(context.Context) ([]blockless.PeerReputation, error)
+	NodeInfoFunc                 func(context.Context) blockless.NodeInfo
+	KnownPeersFunc               func(context.Context) ([]blockless.Peer, error)
+	ConnectedPeersFunc           func(context.Context) []blockless.ConnectedPeer
+	InstalledFunctionsFunc       func(context.Context) []blockless.InstalledFunction
 }
 
 func BaselineNode(t *testing.T) *APINode {
@@ -24,12 +48,112 @@ func BaselineNode(t *testing.T) *APINode {
 			// TODO: Add a generic cluster info
 			return GenericExecutionResult.Code, GenericUUID.String(), GenericExecutionResultMap, execute.Cluster{}, nil
 		},
-		ExecutionResultFunc: func(id string) (execute.ResultMap, bool) {
-			return GenericExecutionResultMap, true
+		ExecuteFunctionAsyncFunc: func(context.Context, execute.Request, string) (string, error) {
+			return GenericUUID.String(), nil
+		},
+		ExecuteFunctionStreamFunc: func(context.Context, execute.Request, string) (string, <-chan execute.Event, error) {
+
+			requestID := GenericUUID.String()
+
+			events := make(chan execute.Event, 1)
+			events <- execute.Event{
+				Type:      execute.EventDone,
+				RequestID: requestID,
+				Code:      GenericExecutionResult.Code,
+				Results:   GenericExecutionResultMap,
+			}
+			close(events)
+
+			return requestID, events, nil
+		},
+		ExecuteBatchFunc: func(_ context.Context, req execute.BatchRequest, _ string) (codes.Code, string, []execute.BatchItemResult, error) {
+
+			results := make([]execute.BatchItemResult, len(req.Items))
+			for i := range req.Items {
+				results[i] = execute.BatchItemResult{
+					Index:  i,
+					Peer:   GenericPeerID,
+					Result: execute.NodeResult{Result: GenericExecutionResult},
+				}
+			}
+
+			return GenericExecutionResult.Code, GenericUUID.String(), results, nil
 		},
-		PublishFunctionInstallFunc: func(ctx context.Context, uri string, cid string, subgroup string) error {
+		ExecuteMapReduceFunc: func(_ context.Context, req execute.MapReduceRequest, _ string) (codes.Code, string, execute.MapReduceResult, error) {
+
+			batch := req.Map(req.Shards)
+
+			result := execute.MapReduceResult{
+				Shards:  make([]execute.BatchItemResult, len(batch.Items)),
+				Results: GenericExecutionResultMap,
+			}
+			for i := range batch.Items {
+				result.Shards[i] = execute.BatchItemResult{
+					Index:  i,
+					Peer:   GenericPeerID,
+					Result: execute.NodeResult{Result: GenericExecutionResult},
+				}
+			}
+
+			return GenericExecutionResult.Code, GenericUUID.String(), result, nil
+		},
+		ExecutionResultFunc: func(context.Context, string) (blockless.ExecutionRecord, error) {
+			return GenericExecutionRecord, nil
+		},
+		ExecutionStatusFunc: func(context.Context, string) (execute.State, error) {
+			return execute.StateDone, nil
+		},
+		CancelExecutionFunc: func(context.Context, string) error {
 			return nil
 		},
+		PublishFunctionInstallFunc: func(ctx context.Context, uri string, cid string, subgroup string) (blockless.FunctionJob, error) {
+			return GenericInstallJob, nil
+		},
+		InstallStatusFunc: func(context.Context, string) (blockless.FunctionJob, error) {
+			return GenericInstallJob, nil
+		},
+		PublishFunctionUninstallFunc: func(context.Context, string, string) (blockless.FunctionJob, error) {
+			return GenericUninstallJob, nil
+		},
+		UninstallStatusFunc: func(context.Context, string) (blockless.FunctionJob, error) {
+			return GenericUninstallJob, nil
+		},
+		CreateScheduleFunc: func(context.Context, string, execute.Request, string) (blockless.ScheduleRecord, error) {
+			return GenericScheduleRecord, nil
+		},
+		ScheduleFunc: func(context.Context, string) (blockless.ScheduleRecord, error) {
+			return GenericScheduleRecord, nil
+		},
+		SchedulesFunc: func(context.Context) ([]blockless.ScheduleRecord, error) {
+			return []blockless.ScheduleRecord{GenericScheduleRecord}, nil
+		},
+		RemoveScheduleFunc: func(context.Context, string) error {
+			return nil
+		},
+		ExecuteWorkflowFunc: func(context.Context, execute.Workflow, string) (string, error) {
+			return GenericWorkflowRecord.ID, nil
+		},
+		WorkflowFunc: func(context.Context, string) (blockless.WorkflowRecord, error) {
+			return GenericWorkflowRecord, nil
+		},
+		PeerReputationFunc: func(context.Context, peer.ID) (blockless.PeerReputation, error) {
+			return GenericPeerReputation, nil
+		},
+		PeerReputationsFunc: func(context.Context) ([]blockless.PeerReputation, error) {
+			return []blockless.PeerReputation{GenericPeerReputation}, nil
+		},
+		NodeInfoFunc: func(context.Context) blockless.NodeInfo {
+			return GenericNodeInfo
+		},
+		KnownPeersFunc: func(context.Context) ([]blockless.Peer, error) {
+			return []blockless.Peer{GenericPeer}, nil
+		},
+		ConnectedPeersFunc: func(context.Context) []blockless.ConnectedPeer {
+			return []blockless.ConnectedPeer{GenericConnectedPeer}
+		},
+		InstalledFunctionsFunc: func(context.Context) []blockless.InstalledFunction {
+			return []blockless.InstalledFunction{GenericInstalledFunction}
+		},
 	}
 
 	return &node
@@ -39,10 +163,94 @@ func (n *APINode) ExecuteFunction(ctx context.Context, req execute.Request, subg
 	return n.ExecuteFunctionFunc(ctx, req, subgroup)
 }
 
-func (n *APINode) ExecutionResult(id string) (execute.ResultMap, bool) {
-	return n.ExecutionResultFunc(id)
+func (n *APINode) ExecuteFunctionAsync(ctx context.Context, req execute.Request, subgroup string) (string, error) {
+	return n.ExecuteFunctionAsyncFunc(ctx, req, subgroup)
+}
+
+func (n *APINode) ExecuteFunctionStream(ctx context.Context, req execute.Request, subgroup string) (string, <-chan execute.Event, error) {
+	return n.ExecuteFunctionStreamFunc(ctx, req, subgroup)
+}
+
+func (n *APINode) ExecuteBatch(ctx context.Context, req execute.BatchRequest, subgroup string) (codes.Code, string, []execute.BatchItemResult, error) {
+	return n.ExecuteBatchFunc(ctx, req, subgroup)
+}
+
+func (n *APINode) ExecuteMapReduce(ctx context.Context, req execute.MapReduceRequest, subgroup string) (codes.Code, string, execute.MapReduceResult, error) {
+	return n.ExecuteMapReduceFunc(ctx, req, subgroup)
 }
 
-func (n *APINode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) error {
+func (n *APINode) ExecutionResult(ctx context.Context, id string) (blockless.ExecutionRecord, error) {
+	return n.ExecutionResultFunc(ctx, id)
+}
+
+func (n *APINode) ExecutionStatus(ctx context.Context, id string) (execute.State, error) {
+	return n.ExecutionStatusFunc(ctx, id)
+}
+
+func (n *APINode) CancelExecution(ctx context.Context, id string) error {
+	return n.CancelExecutionFunc(ctx, id)
+}
+
+func (n *APINode) PublishFunctionInstall(ctx context.Context, uri string, cid string, subgroup string) (blockless.FunctionJob, error) {
 	return n.PublishFunctionInstallFunc(ctx, uri, cid, subgroup)
 }
+
+func (n *APINode) InstallStatus(ctx context.Context, cid string) (blockless.FunctionJob, error) {
+	return n.InstallStatusFunc(ctx, cid)
+}
+
+func (n *APINode) PublishFunctionUninstall(ctx context.Context, cid string, subgroup string) (blockless.FunctionJob, error) {
+	return n.PublishFunctionUninstallFunc(ctx, cid, subgroup)
+}
+
+func (n *APINode) UninstallStatus(ctx context.Context, cid string) (blockless.FunctionJob, error) {
+	return n.UninstallStatusFunc(ctx, cid)
+}
+
+func (n *APINode) CreateSchedule(ctx context.Context, cron string, req execute.Request, subgroup string) (blockless.ScheduleRecord, error) {
+	return n.CreateScheduleFunc(ctx, cron, req, subgroup)
+}
+
+func (n *APINode) Schedule(ctx context.Context, id string) (blockless.ScheduleRecord, error) {
+	return n.ScheduleFunc(ctx, id)
+}
+
+func (n *APINode) Schedules(ctx context.Context) ([]blockless.ScheduleRecord, error) {
+	return n.SchedulesFunc(ctx)
+}
+
+func (n *APINode) RemoveSchedule(ctx context.Context, id string) error {
+	return n.RemoveScheduleFunc(ctx, id)
+}
+
+func (n *APINode) ExecuteWorkflow(ctx context.Context, workflow execute.Workflow, subgroup string) (string, error) {
+	return n.ExecuteWorkflowFunc(ctx, workflow, subgroup)
+}
+
+func (n *APINode) Workflow(ctx context.Context, id string) (blockless.WorkflowRecord, error) {
+	return n.WorkflowFunc(ctx, id)
+}
+
+func (n *APINode) PeerReputation(ctx context.Context, id peer.ID) (blockless.PeerReputation, error) {
+	return n.PeerReputationFunc(ctx, id)
+}
+
+func (n *APINode) PeerReputations(ctx context.Context) ([]blockless.PeerReputation, error) {
+	return n.PeerReputationsFunc(ctx)
+}
+
+func (n *APINode) NodeInfo(ctx context.Context) blockless.NodeInfo {
+	return n.NodeInfoFunc(ctx)
+}
+
+func (n *APINode) KnownPeers(ctx context.Context) ([]blockless.Peer, error) {
+	return n.KnownPeersFunc(ctx)
+}
+
+func (n *APINode) ConnectedPeers(ctx context.Context) []blockless.ConnectedPeer {
+	return n.ConnectedPeersFunc(ctx)
+}
+
+func (n *APINode) InstalledFunctions(ctx context.Context) []blockless.InstalledFunction {
+	return n.InstalledFunctionsFunc(ctx)
+}
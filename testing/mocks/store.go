@@ -19,6 +19,24 @@ type Store struct {
 	RetrieveFunctionFunc  func(context.Context, string) (blockless.FunctionRecord, error)
 	RetrieveFunctionsFunc func(context.Context) ([]blockless.FunctionRecord, error)
 	RemoveFunctionFunc    func(context.Context, string) error
+
+	SaveExecutionResultFunc      func(context.Context, blockless.ExecutionRecord) error
+	RetrieveExecutionResultFunc  func(context.Context, string) (blockless.ExecutionRecord, error)
+	RetrieveExecutionResultsFunc func(context.Context) ([]blockless.ExecutionRecord, error)
+	RemoveExecutionResultFunc    func(context.Context, string) error
+
+	SaveScheduleFunc      func(context.Context, blockless.ScheduleRecord) error
+	RetrieveScheduleFunc  func(context.Context, string) (blockless.ScheduleRecord, error)
+	RetrieveSchedulesFunc func(context.Context) ([]blockless.ScheduleRecord, error)
+	RemoveScheduleFunc    func(context.Context, string) error
+
+	SaveWorkflowFunc      func(context.Context, blockless.WorkflowRecord) error
+	RetrieveWorkflowFunc  func(context.Context, string) (blockless.WorkflowRecord, error)
+	RetrieveWorkflowsFunc func(context.Context) ([]blockless.WorkflowRecord, error)
+
+	SaveReputationFunc      func(context.Context, blockless.PeerReputation) error
+	RetrieveReputationFunc  func(context.Context, peer.ID) (blockless.PeerReputation, error)
+	RetrieveReputationsFunc func(context.Context) ([]blockless.PeerReputation, error)
 }
 
 func BaselineStore(t *testing.T) *Store {
@@ -50,6 +68,52 @@ func BaselineStore(t *testing.T) *Store {
 		RemoveFunctionFunc: func(context.Context, string) error {
 			return nil
 		},
+
+		SaveExecutionResultFunc: func(context.Context, blockless.ExecutionRecord) error {
+			return nil
+		},
+		RetrieveExecutionResultFunc: func(context.Context, string) (blockless.ExecutionRecord, error) {
+			return GenericExecutionRecord, nil
+		},
+		RetrieveExecutionResultsFunc: func(context.Context) ([]blockless.ExecutionRecord, error) {
+			return []blockless.ExecutionRecord{GenericExecutionRecord}, nil
+		},
+		RemoveExecutionResultFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveScheduleFunc: func(context.Context, blockless.ScheduleRecord) error {
+			return nil
+		},
+		RetrieveScheduleFunc: func(context.Context, string) (blockless.ScheduleRecord, error) {
+			return GenericScheduleRecord, nil
+		},
+		RetrieveSchedulesFunc: func(context.Context) ([]blockless.ScheduleRecord, error) {
+			return []blockless.ScheduleRecord{GenericScheduleRecord}, nil
+		},
+		RemoveScheduleFunc: func(context.Context, string) error {
+			return nil
+		},
+
+		SaveWorkflowFunc: func(context.Context, blockless.WorkflowRecord) error {
+			return nil
+		},
+		RetrieveWorkflowFunc: func(context.Context, string) (blockless.WorkflowRecord, error) {
+			return GenericWorkflowRecord, nil
+		},
+		RetrieveWorkflowsFunc: func(context.Context) ([]blockless.WorkflowRecord, error) {
+			return []blockless.WorkflowRecord{GenericWorkflowRecord}, nil
+		},
+
+		SaveReputationFunc: func(context.Context, blockless.PeerReputation) error {
+			return nil
+		},
+		RetrieveReputationFunc: func(context.Context, peer.ID) (blockless.PeerReputation, error) {
+			return GenericPeerReputation, nil
+		},
+		RetrieveReputationsFunc: func(context.Context) ([]blockless.PeerReputation, error) {
+			return []blockless.PeerReputation{GenericPeerReputation}, nil
+		},
 	}
 
 	return &store
@@ -79,3 +143,45 @@ func (s *Store) RemovePeer(ctx context.Context, id peer.ID) error {
 func (s *Store) RemoveFunction(ctx context.Context, id string) error {
 	return s.RemoveFunctionFunc(ctx, id)
 }
+func (s *Store) SaveExecutionResult(ctx context.Context, record blockless.ExecutionRecord) error {
+	return s.SaveExecutionResultFunc(ctx, record)
+}
+func (s *Store) RetrieveExecutionResult(ctx context.Context, id string) (blockless.ExecutionRecord, error) {
+	return s.RetrieveExecutionResultFunc(ctx, id)
+}
+func (s *Store) RetrieveExecutionResults(ctx context.Context) ([]blockless.ExecutionRecord, error) {
+	return s.RetrieveExecutionResultsFunc(ctx)
+}
+func (s *Store) RemoveExecutionResult(ctx context.Context, id string) error {
+	return s.RemoveExecutionResultFunc(ctx, id)
+}
+func (s *Store) SaveSchedule(ctx context.Context, schedule blockless.ScheduleRecord) error {
+	return s.SaveScheduleFunc(ctx, schedule)
+}
+func (s *Store) RetrieveSchedule(ctx context.Context, id string) (blockless.ScheduleRecord, error) {
+	return s.RetrieveScheduleFunc(ctx, id)
+}
+func (s *Store) RetrieveSchedules(ctx context.Context) ([]blockless.ScheduleRecord, error) {
+	return s.RetrieveSchedulesFunc(ctx)
+}
+func (s *Store) RemoveSchedule(ctx context.Context, id string) error {
+	return s.RemoveScheduleFunc(ctx, id)
+}
+func (s *Store) SaveWorkflow(ctx context.Context, workflow blockless.WorkflowRecord) error {
+	return s.SaveWorkflowFunc(ctx, workflow)
+}
+func (s *Store) RetrieveWorkflow(ctx context.Context, id string) (blockless.WorkflowRecord, error) {
+	return s.RetrieveWorkflowFunc(ctx, id)
+}
+func (s *Store) RetrieveWorkflows(ctx context.Context) ([]blockless.WorkflowRecord, error) {
+	return s.RetrieveWorkflowsFunc(ctx)
+}
+func (s *Store) SaveReputation(ctx context.Context, reputation blockless.PeerReputation) error {
+	return s.SaveReputationFunc(ctx, reputation)
+}
+func (s *Store) RetrieveReputation(ctx context.Context, id peer.ID) (blockless.PeerReputation, error) {
+	return s.RetrieveReputationFunc(ctx, id)
+}
+func (s *Store) RetrieveReputations(ctx context.Context) ([]blockless.PeerReputation, error) {
+	return s.RetrieveReputationsFunc(ctx)
+}